@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"pullrequest-service/internal/api/http/types"
+	"pullrequest-service/internal/dump"
+	"pullrequest-service/internal/entity"
+	"pullrequest-service/internal/usecase"
+)
+
+func runCommand(ctx context.Context, name string, args []string, dumpUsecase *usecase.DumpUsecase) error {
+	switch name {
+	case "export":
+		return runExport(ctx, args, dumpUsecase)
+	case "import":
+		return runImport(ctx, args, dumpUsecase)
+	}
+	return fmt.Errorf("unknown command %q, expected export or import", name)
+}
+
+func runExport(ctx context.Context, args []string, dumpUsecase *usecase.DumpUsecase) error {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	formatFlag := fs.String("format", string(dump.FormatJSON), "dump format: json or ndjson")
+	outFlag := fs.String("out", "", "output file, stdout if empty")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	format, err := dump.ParseFormat(*formatFlag)
+	if err != nil {
+		return err
+	}
+
+	data, err := dumpUsecase.Export(ctx)
+	if err != nil {
+		return err
+	}
+
+	if *outFlag == "" {
+		return dump.Encode(os.Stdout, format, data)
+	}
+
+	f, err := os.Create(*outFlag)
+	if err != nil {
+		return fmt.Errorf("create output file: %w", err)
+	}
+
+	if err := dump.Encode(f, format, data); err != nil {
+		f.Close()
+		return err
+	}
+
+	// The dump is only complete once the file is closed without an error.
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("close output file: %w", err)
+	}
+
+	return nil
+}
+
+func runImport(ctx context.Context, args []string, dumpUsecase *usecase.DumpUsecase) error {
+	fs := flag.NewFlagSet("import", flag.ContinueOnError)
+	formatFlag := fs.String("format", string(dump.FormatJSON), "dump format: json or ndjson")
+	inFlag := fs.String("in", "", "input file, stdin if empty")
+	policyFlag := fs.String("policy", string(entity.ConflictFail), "conflict policy: skip, overwrite or fail")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	format, err := dump.ParseFormat(*formatFlag)
+	if err != nil {
+		return err
+	}
+
+	var in io.Reader = os.Stdin
+	if *inFlag != "" {
+		f, err := os.Open(*inFlag)
+		if err != nil {
+			return fmt.Errorf("open input file: %w", err)
+		}
+		defer f.Close()
+		in = f
+	}
+
+	data, err := dump.Decode(in, format)
+	if err != nil {
+		return err
+	}
+
+	res, err := dumpUsecase.Import(ctx, data, entity.ConflictPolicy(*policyFlag))
+	if err != nil {
+		return err
+	}
+
+	return json.NewEncoder(os.Stdout).Encode(types.FromEntityImportResult(res))
+}
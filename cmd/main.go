@@ -10,9 +10,19 @@ import (
 	"os/signal"
 	handler "pullrequest-service/internal/api/http/handlers"
 	"pullrequest-service/internal/api/http/router"
+	"pullrequest-service/internal/chat"
 	"pullrequest-service/internal/config"
+	"pullrequest-service/internal/entity"
+	"pullrequest-service/internal/events"
+	"pullrequest-service/internal/integrations/github"
+	"pullrequest-service/internal/integrations/gitlab"
+	"pullrequest-service/internal/mail"
 	"pullrequest-service/internal/repository/postgres"
 	"pullrequest-service/internal/usecase"
+	"pullrequest-service/internal/webhook"
+	"pullrequest-service/internal/worker"
+	"slices"
+	"strings"
 	"syscall"
 	"time"
 
@@ -29,8 +39,52 @@ func applySchema(db *sql.DB, path string) error {
 	return err
 }
 
+func buildEventSinks(names []string, filePath string, named ...usecase.EventSink) ([]usecase.EventSink, func(), error) {
+	sinks := make([]usecase.EventSink, 0, len(names))
+	closers := make([]func() error, 0)
+
+	closeAll := func() {
+		for _, c := range closers {
+			c()
+		}
+	}
+
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+
+		switch name {
+		case "stdout":
+			sinks = append(sinks, events.NewWriterSink("stdout", os.Stdout))
+		case "file":
+			f, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+			if err != nil {
+				closeAll()
+				return nil, nil, fmt.Errorf("open event file: %w", err)
+			}
+			closers = append(closers, f.Close)
+			sinks = append(sinks, events.NewWriterSink("file", f))
+		case "":
+		default:
+			idx := slices.IndexFunc(named, func(sink usecase.EventSink) bool { return sink.Name() == name })
+			if idx < 0 {
+				closeAll()
+				return nil, nil, fmt.Errorf("unknown event sink %q", name)
+			}
+			sinks = append(sinks, named[idx])
+		}
+	}
+
+	return sinks, closeAll, nil
+}
+
 func main() {
-	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	logOutput := os.Stdout
+	if len(os.Args) > 1 {
+		// export writes the dump to stdout, so CLI commands log to stderr
+		logOutput = os.Stderr
+	}
+
+	logger := slog.New(slog.NewJSONHandler(logOutput, nil))
 	logger.Info("starting service")
 
 	cfg, err := config.LoadConfig()
@@ -73,22 +127,144 @@ func main() {
 	teamRepo := postgres.NewPostgresTeamRepository(db)
 	userRepo := postgres.NewPostgresUserRepository(db)
 	prRepo := postgres.NewPostgresPRRepository(db)
+	availabilityRepo := postgres.NewPostgresAvailabilityRepository(db)
+	codeOwnerRepo := postgres.NewPostgresCodeOwnerRepository(db)
+	webhookRepo := postgres.NewPostgresWebhookRepository(db)
+	accountRepo := postgres.NewPostgresAccountRepository(db)
+	notificationRepo := postgres.NewPostgresNotificationRepository(db)
+	digestRepo := postgres.NewPostgresDigestRepository(db)
+	outboxRepo := postgres.NewPostgresOutboxRepository(db)
 	txMgr := postgres.NewTxManager(db)
 
-	teamUsecase := usecase.NewTeamUsecase(teamRepo, userRepo, txMgr, logger)
-	userUsecase := usecase.NewUserUsecase(userRepo, prRepo, logger)
-	prUsecase := usecase.NewPRUsecase(prRepo, userRepo, teamRepo, txMgr, logger)
+	codeOwners := usecase.NewCodeOwners(codeOwnerRepo)
+	selector := usecase.NewReviewerSelector(userRepo, teamRepo, prRepo, availabilityRepo, codeOwners, logger)
+
+	webhookRetry := entity.RetryPolicy{
+		MaxAttempts: cfg.Webhooks.MaxAttempts,
+		BaseDelay:   cfg.Webhooks.BackoffBase,
+		MaxDelay:    cfg.Webhooks.BackoffMax,
+	}
+	webhookUsecase := usecase.NewWebhookUsecase(webhookRepo, webhook.NewClient(cfg.Webhooks.Timeout), webhookRetry, logger)
+
+	var reviewerPublishers []usecase.ReviewerPublisher
+	var authorResolvers []usecase.AuthorResolver
+	if cfg.Integrations.GitHubToken != "" {
+		reviewerPublishers = append(reviewerPublishers, github.NewClient(cfg.Integrations.GitHubAPIURL, cfg.Integrations.GitHubToken, cfg.Integrations.Timeout))
+	}
+	if cfg.Integrations.GitLabURL != "" && cfg.Integrations.GitLabToken != "" {
+		gitlabClient := gitlab.NewClient(cfg.Integrations.GitLabURL, cfg.Integrations.GitLabToken, cfg.Integrations.Timeout)
+		reviewerPublishers = append(reviewerPublishers, gitlabClient)
+		authorResolvers = append(authorResolvers, gitlabClient)
+	}
+
+	reviewerSyncUsecase := usecase.NewReviewerSyncUsecase(accountRepo, reviewerPublishers, logger)
+
+	var chatSenders []usecase.ChatSender
+	if cfg.Notifications.SlackWebhookURL != "" {
+		chatSenders = append(chatSenders, chat.NewSlackSender(cfg.Notifications.SlackWebhookURL, cfg.Notifications.Timeout))
+	}
+	if cfg.Notifications.MattermostWebhookURL != "" {
+		chatSenders = append(chatSenders, chat.NewMattermostSender(cfg.Notifications.MattermostWebhookURL, cfg.Notifications.Timeout))
+	}
+
+	notificationRetry := entity.RetryPolicy{
+		MaxAttempts: cfg.Notifications.MaxAttempts,
+		BaseDelay:   cfg.Notifications.BackoffBase,
+		MaxDelay:    cfg.Notifications.BackoffMax,
+	}
+	notificationUsecase := usecase.NewNotificationUsecase(notificationRepo, userRepo, chatSenders, notificationRetry, logger)
+
+	sinks, closeSinks, err := buildEventSinks(cfg.Outbox.Sinks, cfg.Outbox.FilePath, webhookUsecase, reviewerSyncUsecase, notificationUsecase)
+	if err != nil {
+		logger.Error("failed to build event sinks", "error", err)
+		os.Exit(1)
+	}
+	defer closeSinks()
+
+	outboxRetry := entity.RetryPolicy{
+		MaxAttempts: cfg.Outbox.MaxAttempts,
+		BaseDelay:   cfg.Outbox.BackoffBase,
+		MaxDelay:    cfg.Outbox.BackoffMax,
+	}
+	outboxUsecase := usecase.NewOutboxUsecase(outboxRepo, sinks, outboxRetry, txMgr, logger)
+
+	teamUsecase := usecase.NewTeamUsecase(teamRepo, userRepo, prRepo, selector, txMgr, outboxUsecase, logger)
+	userUsecase := usecase.NewUserUsecase(userRepo, prRepo, txMgr, outboxUsecase, logger)
+	prUsecase := usecase.NewPRUsecase(prRepo, userRepo, teamRepo, selector, txMgr, outboxUsecase, logger)
+	dumpUsecase := usecase.NewDumpUsecase(teamRepo, userRepo, prRepo, txMgr, logger)
+	availabilityUsecase := usecase.NewAvailabilityUsecase(availabilityRepo, userRepo, prRepo, prUsecase, logger)
+	codeOwnersUsecase := usecase.NewCodeOwnersUsecase(codeOwnerRepo, teamRepo, userRepo, logger)
+	reviewSLAUsecase := usecase.NewReviewSLAUsecase(prRepo, prUsecase, txMgr, logger)
+	integrationUsecase := usecase.NewIntegrationUsecase(accountRepo, userRepo, prUsecase, authorResolvers, logger)
+	mailer := mail.NewSMTPSender(cfg.Email.SMTPHost, cfg.Email.SMTPPort, cfg.Email.SMTPUsername, cfg.Email.SMTPPassword, cfg.Email.From,
+		cfg.Email.SMTPStartTLS, cfg.Email.SMTPTimeout)
+	eventHub := events.NewHub()
+	streamUsecase := usecase.NewStreamUsecase(outboxRepo, teamRepo, userRepo, eventHub, logger)
+	digestUsecase := usecase.NewDigestUsecase(prRepo, userRepo, teamRepo, digestRepo, mailer, logger)
+
+	if len(os.Args) > 1 {
+		if err := runCommand(context.Background(), os.Args[1], os.Args[2:], dumpUsecase); err != nil {
+			logger.Error("command failed", "command", os.Args[1], "error", err)
+			os.Exit(1)
+		}
+		return
+	}
 
 	teamHandler := handler.NewTeamHandler(teamUsecase)
 	userHandler := handler.NewUserHandler(userUsecase)
 	prHandler := handler.NewPRHandler(prUsecase)
+	dumpHandler := handler.NewDumpHandler(dumpUsecase, logger)
+	availabilityHandler := handler.NewAvailabilityHandler(availabilityUsecase)
+	codeOwnersHandler := handler.NewCodeOwnersHandler(codeOwnersUsecase)
+	webhookHandler := handler.NewWebhookHandler(webhookUsecase)
+	integrationHandler := handler.NewIntegrationHandler(integrationUsecase, cfg.Integrations.GitHubWebhookSecret,
+		cfg.Integrations.GitLabWebhookToken)
+	notificationHandler := handler.NewNotificationHandler(notificationUsecase)
+	streamHandler := handler.NewStreamHandler(streamUsecase)
 
-	r := router.NewRouter(teamHandler, userHandler, prHandler)
+	r := router.NewRouter(teamHandler, userHandler, prHandler, dumpHandler, availabilityHandler, codeOwnersHandler, webhookHandler,
+		integrationHandler, notificationHandler, streamHandler)
 
 	srv := &http.Server{
 		Addr:    ":" + cfg.Server.Port,
 		Handler: r,
 	}
+	srv.RegisterOnShutdown(eventHub.Close)
+
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+
+	if cfg.Availability.ReassignInterval > 0 {
+		go worker.NewPeriodic("availability_reassign", cfg.Availability.ReassignInterval, availabilityUsecase.ReassignStartedWindows, logger).Run(workersCtx)
+	}
+
+	if cfg.SLA.CheckInterval > 0 {
+		go worker.NewPeriodic("review_sla", cfg.SLA.CheckInterval, reviewSLAUsecase.EscalateOverdue, logger).Run(workersCtx)
+	}
+
+	if cfg.EventFeed.PollInterval > 0 {
+		go postgres.NewEventFeed(db, connectionString, cfg.EventFeed.PollInterval, logger).Run(workersCtx, eventHub.Broadcast)
+	}
+
+	if cfg.Outbox.RelayInterval > 0 {
+		go worker.NewPeriodic("outbox_relay", cfg.Outbox.RelayInterval, outboxUsecase.Relay, logger).Run(workersCtx)
+	}
+
+	if cfg.Webhooks.DeliveryInterval > 0 {
+		go worker.NewPeriodic("webhook_delivery", cfg.Webhooks.DeliveryInterval, webhookUsecase.DeliverPending, logger).Run(workersCtx)
+	}
+
+	if cfg.Notifications.DeliveryInterval > 0 {
+		go worker.NewPeriodic("notification_delivery", cfg.Notifications.DeliveryInterval, notificationUsecase.SendPending, logger).Run(workersCtx)
+	}
+
+	if cfg.Notifications.DigestInterval > 0 {
+		go worker.NewPeriodic("notification_digest", cfg.Notifications.DigestInterval, notificationUsecase.SendDigests, logger).Run(workersCtx)
+	}
+
+	if cfg.Email.SMTPHost != "" && cfg.Email.DigestInterval > 0 {
+		go worker.NewPeriodic("email_digest", cfg.Email.DigestInterval, digestUsecase.SendDigests, logger).Run(workersCtx)
+	}
 
 	go func() {
 		logger.Info("server started", "port", cfg.Server.Port)
@@ -104,6 +280,8 @@ func main() {
 	<-stop
 	logger.Warn("shutdown signal received")
 
+	stopWorkers()
+
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
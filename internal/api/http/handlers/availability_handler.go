@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"net/http"
+	"pullrequest-service/internal/api/http/types"
+)
+
+type AvailabilityHandler struct {
+	availabilityUsecase AvailabilityUsecase
+}
+
+func NewAvailabilityHandler(availabilityUsecase AvailabilityUsecase) *AvailabilityHandler {
+	return &AvailabilityHandler{availabilityUsecase: availabilityUsecase}
+}
+
+func (h *AvailabilityHandler) AddUnavailability(w http.ResponseWriter, r *http.Request) {
+	req, err := types.ParseUnavailabilityRequest(r)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	window, err := h.availabilityUsecase.AddUnavailability(r.Context(), req.ToEntity())
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	resp := types.UnavailabilityResponseDTO{
+		Unavailability: types.FromEntityUnavailability(window),
+	}
+
+	types.WriteJSON(w, http.StatusCreated, resp)
+}
+
+func (h *AvailabilityHandler) GetUnavailabilities(w http.ResponseWriter, r *http.Request) {
+	userId := r.URL.Query().Get("user_id")
+
+	windows, err := h.availabilityUsecase.GetUnavailabilities(r.Context(), userId)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	resp := types.UnavailabilityListResponseDTO{
+		UserID:         userId,
+		Unavailability: types.FromEntityUnavailabilities(windows),
+	}
+
+	types.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *AvailabilityHandler) DeleteUnavailability(w http.ResponseWriter, r *http.Request) {
+	req, err := types.ParseDeleteUnavailabilityRequest(r)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	if err := h.availabilityUsecase.DeleteUnavailability(r.Context(), req.ID); err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"net/http"
+	"pullrequest-service/internal/api/http/types"
+)
+
+type CodeOwnersHandler struct {
+	codeOwnersUsecase CodeOwnersUsecase
+}
+
+func NewCodeOwnersHandler(codeOwnersUsecase CodeOwnersUsecase) *CodeOwnersHandler {
+	return &CodeOwnersHandler{codeOwnersUsecase: codeOwnersUsecase}
+}
+
+func (h *CodeOwnersHandler) AddRule(w http.ResponseWriter, r *http.Request) {
+	req, err := types.ParseCodeOwnerRuleRequest(r)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	rule, err := h.codeOwnersUsecase.AddRule(r.Context(), req.ToEntity())
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	resp := types.CodeOwnerRuleResponseDTO{
+		Rule: types.FromEntityCodeOwnerRule(rule),
+	}
+
+	types.WriteJSON(w, http.StatusCreated, resp)
+}
+
+func (h *CodeOwnersHandler) GetRules(w http.ResponseWriter, r *http.Request) {
+	teamName := r.URL.Query().Get("team_name")
+
+	rules, err := h.codeOwnersUsecase.GetRules(r.Context(), teamName)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	resp := types.CodeOwnerRulesResponseDTO{
+		TeamName: teamName,
+		Rules:    types.FromEntityCodeOwnerRules(rules),
+	}
+
+	types.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *CodeOwnersHandler) DeleteRule(w http.ResponseWriter, r *http.Request) {
+	req, err := types.ParseDeleteCodeOwnerRuleRequest(r)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	if err := h.codeOwnersUsecase.DeleteRule(r.Context(), req.ID); err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -8,15 +8,82 @@ import (
 type UserUsecase interface {
 	SetActiveFlag(ctx context.Context, userId string, isActive bool) (*entity.User, error)
 	GetPR(ctx context.Context, userId string) ([]entity.PullRequestShort, error)
+	SetUsername(ctx context.Context, userId string, username string) (*entity.User, error)
+	GetUser(ctx context.Context, userId string) (*entity.User, error)
+	ListUsers(ctx context.Context, filter *entity.UserFilter) ([]entity.User, error)
+	SetMaxOpenReviews(ctx context.Context, userId string, maxOpenReviews *int) (*entity.User, error)
+	SetTags(ctx context.Context, userId string, tags []string) (*entity.User, error)
+	SetSeniority(ctx context.Context, userId string, seniority entity.Seniority) (*entity.User, error)
+	SetWorkingHours(ctx context.Context, userId string, hours entity.WorkingHours) (*entity.User, error)
+	SetEmail(ctx context.Context, userId string, email string) (*entity.User, error)
+	GetReviewerStats(ctx context.Context, userId string) (*entity.ReviewerStats, error)
 }
 
 type TeamUsecase interface {
 	AddTeam(ctx context.Context, team *entity.Team) error
 	GetTeam(ctx context.Context, teamName string) (*entity.Team, error)
+	SyncTeam(ctx context.Context, team *entity.Team, dryRun bool) (*entity.TeamSyncPlan, error)
+	SetDefaultMaxOpenReviews(ctx context.Context, teamName string, maxOpenReviews *int) error
+	SetFallbackTeams(ctx context.Context, teamName string, fallbackTeams []string) error
+	GetFallbackTeams(ctx context.Context, teamName string) ([]string, error)
+	SetPolicy(ctx context.Context, teamName string, policy *entity.TeamPolicy) error
+	GetPolicy(ctx context.Context, teamName string) (*entity.TeamPolicy, error)
 }
 
 type PRUsecase interface {
 	MergePR(ctx context.Context, prId string) (*entity.PullRequest, error)
-	CreatePR(ctx context.Context, prId string, prName string, authorId string) (*entity.PullRequest, error)
+	ClosePR(ctx context.Context, prId string) (*entity.PullRequest, error)
+	ReopenPR(ctx context.Context, prId string) (*entity.PullRequest, error)
+	CreatePR(ctx context.Context, req *entity.CreatePRRequest) (*entity.PullRequest, error)
 	ReAssign(ctx context.Context, prId, oldReviewerId string) (*entity.PullRequest, error)
+	ReAssignTo(ctx context.Context, prId, oldReviewerId, newReviewerId string) (*entity.PullRequest, error)
+	Decline(ctx context.Context, prId, reviewerId, reason string) (*entity.PullRequest, error)
+	GetAssignmentHistory(ctx context.Context, prId string) ([]entity.AssignmentRecord, error)
+	SuggestReviewers(ctx context.Context, req *entity.SuggestReviewersRequest) (*entity.ReviewerSelection, error)
+	AddReviewer(ctx context.Context, prId, reviewerId string) (*entity.PullRequest, error)
+	RemoveReviewer(ctx context.Context, prId, reviewerId string) (*entity.PullRequest, error)
+	Respond(ctx context.Context, prId, reviewerId string) (*entity.PullRequest, error)
+	GetOverduePRs(ctx context.Context) ([]entity.PullRequest, error)
+}
+
+type DumpUsecase interface {
+	Export(ctx context.Context) (*entity.Dump, error)
+	Import(ctx context.Context, dump *entity.Dump, policy entity.ConflictPolicy) (*entity.ImportResult, error)
+}
+
+type AvailabilityUsecase interface {
+	AddUnavailability(ctx context.Context, window *entity.Unavailability) (*entity.Unavailability, error)
+	GetUnavailabilities(ctx context.Context, userId string) ([]entity.Unavailability, error)
+	DeleteUnavailability(ctx context.Context, id int64) error
+}
+
+type CodeOwnersUsecase interface {
+	AddRule(ctx context.Context, rule *entity.CodeOwnerRule) (*entity.CodeOwnerRule, error)
+	GetRules(ctx context.Context, teamName string) ([]entity.CodeOwnerRule, error)
+	DeleteRule(ctx context.Context, id int64) error
+}
+
+type IntegrationUsecase interface {
+	SetAccount(ctx context.Context, account *entity.ExternalAccount) (*entity.ExternalAccount, error)
+	ListAccounts(ctx context.Context, provider entity.Provider) ([]entity.ExternalAccount, error)
+	DeleteAccount(ctx context.Context, provider entity.Provider, login string) error
+	ListUnmapped(ctx context.Context, provider entity.Provider) ([]entity.UnmappedEvent, error)
+	DismissUnmapped(ctx context.Context, id int64) error
+	HandlePullRequest(ctx context.Context, event *entity.ExternalPREvent) (*entity.IngestResult, error)
+}
+
+type WebhookUsecase interface {
+	CreateWebhook(ctx context.Context, hook *entity.Webhook) (*entity.Webhook, error)
+	ListWebhooks(ctx context.Context) ([]entity.Webhook, error)
+	DeleteWebhook(ctx context.Context, id int64) error
+	GetDeliveries(ctx context.Context, filter *entity.DeliveryFilter) ([]entity.WebhookDelivery, error)
+}
+
+type NotificationUsecase interface {
+	SetPreferences(ctx context.Context, prefs *entity.NotificationPreferences) (*entity.NotificationPreferences, error)
+	GetPreferences(ctx context.Context, userId string) (*entity.NotificationPreferences, error)
+}
+
+type StreamUsecase interface {
+	Subscribe(ctx context.Context, filter *entity.EventStreamFilter, lastEventId string) (*entity.EventSubscription, error)
 }
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+	"pullrequest-service/internal/api/http/types"
+	"pullrequest-service/internal/dump"
+)
+
+type DumpHandler struct {
+	dumpUsecase DumpUsecase
+	logger      *slog.Logger
+}
+
+func NewDumpHandler(dumpUsecase DumpUsecase, logger *slog.Logger) *DumpHandler {
+	return &DumpHandler{dumpUsecase: dumpUsecase, logger: logger}
+}
+
+func (h *DumpHandler) Export(w http.ResponseWriter, r *http.Request) {
+	format, err := dump.ParseFormat(r.URL.Query().Get("format"))
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	data, err := h.dumpUsecase.Export(r.Context())
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", format.ContentType())
+	w.WriteHeader(http.StatusOK)
+
+	// The status is already sent, so a failed write can only be logged.
+	if err := dump.Encode(w, format, data); err != nil {
+		h.logger.Error("failed to write dump", "format", format, "error", err)
+	}
+}
+
+func (h *DumpHandler) Import(w http.ResponseWriter, r *http.Request) {
+	format, err := dump.ParseFormat(r.URL.Query().Get("format"))
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	policy, err := types.ParseConflictPolicy(r)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	defer r.Body.Close()
+
+	data, err := dump.Decode(r.Body, format)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	res, err := h.dumpUsecase.Import(r.Context(), data, policy)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	types.WriteJSON(w, http.StatusOK, types.FromEntityImportResult(res))
+}
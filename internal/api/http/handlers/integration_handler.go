@@ -0,0 +1,190 @@
+package handler
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"pullrequest-service/internal/api/http/types"
+	"pullrequest-service/internal/entity"
+	"pullrequest-service/internal/integrations/github"
+	"pullrequest-service/internal/integrations/gitlab"
+)
+
+const maxIntegrationPayloadBytes = 5 << 20
+
+type IntegrationHandler struct {
+	integrationUsecase IntegrationUsecase
+	githubSecret       string
+	gitlabToken        string
+}
+
+func NewIntegrationHandler(integrationUsecase IntegrationUsecase, githubSecret, gitlabToken string) *IntegrationHandler {
+	return &IntegrationHandler{integrationUsecase: integrationUsecase, githubSecret: githubSecret, gitlabToken: gitlabToken}
+}
+
+func (h *IntegrationHandler) GitHubWebhook(w http.ResponseWriter, r *http.Request) {
+	body, err := readPayload(w, r)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	if !github.VerifySignature(h.githubSecret, body, r.Header.Get(github.HeaderSignature)) {
+		types.HandleError(w, entity.ErrUnauthorized)
+		return
+	}
+
+	eventName := r.Header.Get(github.HeaderEvent)
+	if eventName != github.EventPullRequest {
+		writeIgnoredEvent(w, eventName)
+		return
+	}
+
+	event, err := github.ParsePullRequestEvent(body)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	h.ingest(w, r, event)
+}
+
+func (h *IntegrationHandler) GitLabWebhook(w http.ResponseWriter, r *http.Request) {
+	body, err := readPayload(w, r)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	if !gitlab.VerifyToken(h.gitlabToken, r.Header.Get(gitlab.HeaderToken)) {
+		types.HandleError(w, entity.ErrUnauthorized)
+		return
+	}
+
+	eventName := r.Header.Get(gitlab.HeaderEvent)
+	if eventName != gitlab.EventMergeRequest {
+		writeIgnoredEvent(w, eventName)
+		return
+	}
+
+	event, err := gitlab.ParseMergeRequestEvent(body)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	h.ingest(w, r, event)
+}
+
+func (h *IntegrationHandler) ingest(w http.ResponseWriter, r *http.Request, event *entity.ExternalPREvent) {
+	result, err := h.integrationUsecase.HandlePullRequest(r.Context(), event)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	status := http.StatusOK
+	if result.Status == entity.IngestQueued {
+		status = http.StatusAccepted
+	}
+
+	types.WriteJSON(w, status, types.FromEntityIngestResult(result))
+}
+
+func (h *IntegrationHandler) ListUnmapped(w http.ResponseWriter, r *http.Request) {
+	provider := entity.Provider(r.URL.Query().Get("provider"))
+
+	events, err := h.integrationUsecase.ListUnmapped(r.Context(), provider)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	resp := types.UnmappedEventListResponseDTO{
+		Events: types.FromEntityUnmappedEvents(events),
+	}
+
+	types.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *IntegrationHandler) DismissUnmapped(w http.ResponseWriter, r *http.Request) {
+	req, err := types.ParseDismissUnmappedRequest(r)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	if err := h.integrationUsecase.DismissUnmapped(r.Context(), req.ID); err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *IntegrationHandler) SetAccount(w http.ResponseWriter, r *http.Request) {
+	req, err := types.ParseExternalAccountRequest(r)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	account, err := h.integrationUsecase.SetAccount(r.Context(), req.ToEntity())
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	resp := types.ExternalAccountResponseDTO{
+		Account: types.FromEntityAccount(account),
+	}
+
+	types.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *IntegrationHandler) ListAccounts(w http.ResponseWriter, r *http.Request) {
+	provider := entity.Provider(r.URL.Query().Get("provider"))
+
+	accounts, err := h.integrationUsecase.ListAccounts(r.Context(), provider)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	resp := types.ExternalAccountListResponseDTO{
+		Accounts: types.FromEntityAccounts(accounts),
+	}
+
+	types.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *IntegrationHandler) DeleteAccount(w http.ResponseWriter, r *http.Request) {
+	req, err := types.ParseDeleteExternalAccountRequest(r)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	if err := h.integrationUsecase.DeleteAccount(r.Context(), req.Provider, req.Login); err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func readPayload(w http.ResponseWriter, r *http.Request) ([]byte, error) {
+	defer r.Body.Close()
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxIntegrationPayloadBytes))
+	if err != nil {
+		return nil, fmt.Errorf("%w: unreadable payload", entity.ErrInvalidRequest)
+	}
+
+	return body, nil
+}
+
+func writeIgnoredEvent(w http.ResponseWriter, eventName string) {
+	result := &entity.IngestResult{Status: entity.IngestIgnored, Reason: fmt.Sprintf("unsupported event %q", eventName)}
+	types.WriteJSON(w, http.StatusOK, types.FromEntityIngestResult(result))
+}
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"net/http"
+	"pullrequest-service/internal/api/http/types"
+)
+
+type NotificationHandler struct {
+	notificationUsecase NotificationUsecase
+}
+
+func NewNotificationHandler(notificationUsecase NotificationUsecase) *NotificationHandler {
+	return &NotificationHandler{notificationUsecase: notificationUsecase}
+}
+
+func (h *NotificationHandler) SetPreferences(w http.ResponseWriter, r *http.Request) {
+	req, err := types.ParseNotificationPreferencesRequest(r)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	prefs, err := h.notificationUsecase.SetPreferences(r.Context(), req.ToEntity())
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	resp := types.NotificationPreferencesResponseDTO{
+		Preferences: types.FromEntityNotificationPreferences(prefs),
+	}
+
+	types.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *NotificationHandler) GetPreferences(w http.ResponseWriter, r *http.Request) {
+	userId := r.URL.Query().Get("user_id")
+
+	prefs, err := h.notificationUsecase.GetPreferences(r.Context(), userId)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	resp := types.NotificationPreferencesResponseDTO{
+		Preferences: types.FromEntityNotificationPreferences(prefs),
+	}
+
+	types.WriteJSON(w, http.StatusOK, resp)
+}
@@ -1,8 +1,10 @@
 package handler
 
 import (
+	"context"
 	"net/http"
 	"pullrequest-service/internal/api/http/types"
+	"pullrequest-service/internal/entity"
 )
 
 type PRHandler struct {
@@ -20,7 +22,7 @@ func (h *PRHandler) CreatePR(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	pr, err := h.prUsecase.CreatePR(r.Context(), req.PullRequestID, req.PullRequestName, req.AuthorID)
+	pr, err := h.prUsecase.CreatePR(r.Context(), req.ToEntity())
 	if err != nil {
 		types.HandleError(w, err)
 		return
@@ -53,6 +55,34 @@ func (h *PRHandler) MergePR(w http.ResponseWriter, r *http.Request) {
 	types.WriteJSON(w, http.StatusCreated, resp)
 }
 
+func (h *PRHandler) ClosePR(w http.ResponseWriter, r *http.Request) {
+	h.setStatus(w, r, h.prUsecase.ClosePR)
+}
+
+func (h *PRHandler) ReopenPR(w http.ResponseWriter, r *http.Request) {
+	h.setStatus(w, r, h.prUsecase.ReopenPR)
+}
+
+func (h *PRHandler) setStatus(w http.ResponseWriter, r *http.Request, apply func(ctx context.Context, prId string) (*entity.PullRequest, error)) {
+	req, err := types.ParseMergeRequest(r)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	pr, err := apply(r.Context(), req.PullRequestID)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	resp := types.CreatePrResponse{
+		PR: types.FromEntityPR(pr),
+	}
+
+	types.WriteJSON(w, http.StatusOK, resp)
+}
+
 func (h *PRHandler) ReAssign(w http.ResponseWriter, r *http.Request) {
 	req, err := types.ParseReAssignRequest(r)
 	if err != nil {
@@ -60,7 +90,12 @@ func (h *PRHandler) ReAssign(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	pr, err := h.prUsecase.ReAssign(r.Context(), req.PullRequestID, req.OldReviewerId)
+	var pr *entity.PullRequest
+	if req.NewReviewerId != "" {
+		pr, err = h.prUsecase.ReAssignTo(r.Context(), req.PullRequestID, req.OldReviewerId, req.NewReviewerId)
+	} else {
+		pr, err = h.prUsecase.ReAssign(r.Context(), req.PullRequestID, req.OldReviewerId)
+	}
 	if err != nil {
 		types.HandleError(w, err)
 		return
@@ -73,3 +108,126 @@ func (h *PRHandler) ReAssign(w http.ResponseWriter, r *http.Request) {
 
 	types.WriteJSON(w, http.StatusCreated, resp)
 }
+
+func (h *PRHandler) GetAssignmentHistory(w http.ResponseWriter, r *http.Request) {
+	prId := r.URL.Query().Get("pull_request_id")
+
+	history, err := h.prUsecase.GetAssignmentHistory(r.Context(), prId)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	resp := types.AssignmentHistoryResponseDTO{
+		PullRequestID: prId,
+		History:       types.FromEntityAssignmentHistory(history),
+	}
+
+	types.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *PRHandler) SuggestReviewers(w http.ResponseWriter, r *http.Request) {
+	req, err := types.ParseSuggestReviewersRequest(r)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	selection, err := h.prUsecase.SuggestReviewers(r.Context(), req.ToEntity())
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	types.WriteJSON(w, http.StatusOK, types.FromEntitySuggestion(req.AuthorID, selection))
+}
+
+func (h *PRHandler) AddReviewer(w http.ResponseWriter, r *http.Request) {
+	req, err := types.ParseReviewerRequest(r)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	pr, err := h.prUsecase.AddReviewer(r.Context(), req.PullRequestID, req.ReviewerID)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	resp := types.CreatePrResponse{
+		PR: types.FromEntityPR(pr),
+	}
+
+	types.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *PRHandler) RemoveReviewer(w http.ResponseWriter, r *http.Request) {
+	req, err := types.ParseReviewerRequest(r)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	pr, err := h.prUsecase.RemoveReviewer(r.Context(), req.PullRequestID, req.ReviewerID)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	resp := types.CreatePrResponse{
+		PR: types.FromEntityPR(pr),
+	}
+
+	types.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *PRHandler) Decline(w http.ResponseWriter, r *http.Request) {
+	req, err := types.ParseDeclineRequest(r)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	pr, err := h.prUsecase.Decline(r.Context(), req.PullRequestID, req.ReviewerID, req.Reason)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	resp := types.CreatePrResponse{
+		PR: types.FromEntityPR(pr),
+	}
+
+	types.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *PRHandler) Respond(w http.ResponseWriter, r *http.Request) {
+	req, err := types.ParseReviewerRequest(r)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	pr, err := h.prUsecase.Respond(r.Context(), req.PullRequestID, req.ReviewerID)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	resp := types.CreatePrResponse{
+		PR: types.FromEntityPR(pr),
+	}
+
+	types.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *PRHandler) GetOverduePRs(w http.ResponseWriter, r *http.Request) {
+	prs, err := h.prUsecase.GetOverduePRs(r.Context())
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	types.WriteJSON(w, http.StatusOK, types.FromEntityOverduePRs(prs))
+}
@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"pullrequest-service/internal/api/http/types"
+	"pullrequest-service/internal/entity"
+	"time"
+)
+
+const (
+	streamKeepAlive  = 15 * time.Second
+	streamRetryDelay = 3 * time.Second
+)
+
+type StreamHandler struct {
+	streamUsecase StreamUsecase
+}
+
+func NewStreamHandler(streamUsecase StreamUsecase) *StreamHandler {
+	return &StreamHandler{streamUsecase: streamUsecase}
+}
+
+func (h *StreamHandler) Stream(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		types.HandleError(w, entity.ErrInternalError)
+		return
+	}
+
+	filter := &entity.EventStreamFilter{
+		TeamName: r.URL.Query().Get("team_name"),
+		UserID:   r.URL.Query().Get("user_id"),
+	}
+
+	lastEventId := r.Header.Get("Last-Event-ID")
+	if lastEventId == "" {
+		lastEventId = r.URL.Query().Get("last_event_id")
+	}
+
+	sub, err := h.streamUsecase.Subscribe(r.Context(), filter, lastEventId)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+	defer sub.Close()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	fmt.Fprintf(w, "retry: %d\n\n", streamRetryDelay.Milliseconds())
+
+	for i := range sub.Backlog {
+		if err := types.WriteSSEEvent(w, &sub.Backlog[i]); err != nil {
+			return
+		}
+	}
+	flusher.Flush()
+
+	keepAlive := time.NewTicker(streamKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case event, ok := <-sub.Events:
+			if !ok {
+				return
+			}
+			if err := types.WriteSSEEvent(w, &event); err != nil {
+				return
+			}
+			flusher.Flush()
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
@@ -3,7 +3,6 @@ package handler
 import (
 	"net/http"
 	"pullrequest-service/internal/api/http/types"
-	"pullrequest-service/internal/entity"
 )
 
 type TeamHandler struct {
@@ -21,20 +20,7 @@ func (h *TeamHandler) AddTeam(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	team := &entity.Team{
-		TeamName: req.TeamName,
-		Members:  make([]entity.TeamMember, len(req.Members)),
-	}
-
-	for i, m := range req.Members {
-		team.Members[i] = entity.TeamMember{
-			UserID:   m.UserID,
-			UserName: m.UserName,
-			IsActive: m.IsActive,
-		}
-	}
-
-	err = h.teamUsecase.AddTeam(r.Context(), team)
+	err = h.teamUsecase.AddTeam(r.Context(), req.ToEntity())
 	if err != nil {
 		types.HandleError(w, err)
 		return
@@ -58,3 +44,102 @@ func (h *TeamHandler) GetTeam(w http.ResponseWriter, r *http.Request) {
 
 	types.WriteJSON(w, http.StatusCreated, resp)
 }
+
+func (h *TeamHandler) SyncTeam(w http.ResponseWriter, r *http.Request) {
+	dryRun, err := types.ParseDryRun(r)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	req, err := types.ParseTeamRequestDTO(r)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	plan, err := h.teamUsecase.SyncTeam(r.Context(), req.ToEntity(), dryRun)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	types.WriteJSON(w, http.StatusOK, types.FromEntityTeamSyncPlan(plan))
+}
+
+func (h *TeamHandler) SetDefaultMaxOpenReviews(w http.ResponseWriter, r *http.Request) {
+	req, err := types.ParseSetDefaultMaxOpenReviewsRequest(r)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	err = h.teamUsecase.SetDefaultMaxOpenReviews(r.Context(), req.TeamName, req.DefaultMaxOpenReviews)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	types.WriteJSON(w, http.StatusOK, req)
+}
+
+func (h *TeamHandler) SetFallbackTeams(w http.ResponseWriter, r *http.Request) {
+	req, err := types.ParseFallbackTeamsRequest(r)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	err = h.teamUsecase.SetFallbackTeams(r.Context(), req.TeamName, req.FallbackTeams)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	types.WriteJSON(w, http.StatusOK, req)
+}
+
+func (h *TeamHandler) GetFallbackTeams(w http.ResponseWriter, r *http.Request) {
+	teamName := r.URL.Query().Get("team_name")
+
+	fallbackTeams, err := h.teamUsecase.GetFallbackTeams(r.Context(), teamName)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	resp := types.FallbackTeamsDTO{
+		TeamName:      teamName,
+		FallbackTeams: fallbackTeams,
+	}
+
+	types.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *TeamHandler) SetPolicy(w http.ResponseWriter, r *http.Request) {
+	req, err := types.ParseTeamPolicyRequest(r)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	err = h.teamUsecase.SetPolicy(r.Context(), req.TeamName, req.ToEntity())
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	types.WriteJSON(w, http.StatusOK, req)
+}
+
+func (h *TeamHandler) GetPolicy(w http.ResponseWriter, r *http.Request) {
+	teamName := r.URL.Query().Get("team_name")
+
+	policy, err := h.teamUsecase.GetPolicy(r.Context(), teamName)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	types.WriteJSON(w, http.StatusOK, types.FromEntityTeamPolicy(teamName, policy))
+}
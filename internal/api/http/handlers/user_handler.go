@@ -49,3 +49,173 @@ func (h *UserHandler) GetPR(w http.ResponseWriter, r *http.Request) {
 
 	types.WriteJSON(w, http.StatusCreated, resp)
 }
+
+func (h *UserHandler) SetUsername(w http.ResponseWriter, r *http.Request) {
+	req, err := types.ParseSetUsernameRequest(r)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	user, err := h.userUsecase.SetUsername(r.Context(), req.UserId, req.UserName)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	resp := types.SetActiveResponseDTO{
+		User: types.FromEntityUser(user),
+	}
+
+	types.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *UserHandler) GetUser(w http.ResponseWriter, r *http.Request) {
+	userId := r.URL.Query().Get("user_id")
+
+	user, err := h.userUsecase.GetUser(r.Context(), userId)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	resp := types.SetActiveResponseDTO{
+		User: types.FromEntityUser(user),
+	}
+
+	types.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *UserHandler) ListUsers(w http.ResponseWriter, r *http.Request) {
+	filter, err := types.ParseUserFilter(r)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	users, err := h.userUsecase.ListUsers(r.Context(), filter)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	resp := types.UsersListResponseDTO{
+		Users:  types.FromEntityUsers(users),
+		Limit:  filter.Limit,
+		Offset: filter.Offset,
+	}
+
+	types.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *UserHandler) SetMaxOpenReviews(w http.ResponseWriter, r *http.Request) {
+	req, err := types.ParseSetMaxOpenReviewsRequest(r)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	user, err := h.userUsecase.SetMaxOpenReviews(r.Context(), req.UserId, req.MaxOpenReviews)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	resp := types.SetActiveResponseDTO{
+		User: types.FromEntityUser(user),
+	}
+
+	types.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *UserHandler) SetTags(w http.ResponseWriter, r *http.Request) {
+	req, err := types.ParseSetTagsRequest(r)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	user, err := h.userUsecase.SetTags(r.Context(), req.UserId, req.Tags)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	resp := types.SetActiveResponseDTO{
+		User: types.FromEntityUser(user),
+	}
+
+	types.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *UserHandler) SetSeniority(w http.ResponseWriter, r *http.Request) {
+	req, err := types.ParseSetSeniorityRequest(r)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	user, err := h.userUsecase.SetSeniority(r.Context(), req.UserId, req.Seniority)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	resp := types.SetActiveResponseDTO{
+		User: types.FromEntityUser(user),
+	}
+
+	types.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *UserHandler) SetWorkingHours(w http.ResponseWriter, r *http.Request) {
+	req, err := types.ParseSetWorkingHoursRequest(r)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	user, err := h.userUsecase.SetWorkingHours(r.Context(), req.UserId, req.ToEntity())
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	resp := types.SetActiveResponseDTO{
+		User: types.FromEntityUser(user),
+	}
+
+	types.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *UserHandler) SetEmail(w http.ResponseWriter, r *http.Request) {
+	req, err := types.ParseSetEmailRequest(r)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	user, err := h.userUsecase.SetEmail(r.Context(), req.UserId, req.Email)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	resp := types.SetActiveResponseDTO{
+		User: types.FromEntityUser(user),
+	}
+
+	types.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *UserHandler) GetReviewerStats(w http.ResponseWriter, r *http.Request) {
+	userId := r.URL.Query().Get("user_id")
+
+	stats, err := h.userUsecase.GetReviewerStats(r.Context(), userId)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	types.WriteJSON(w, http.StatusOK, types.FromEntityReviewerStats(stats))
+}
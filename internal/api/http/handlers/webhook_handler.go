@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"net/http"
+	"pullrequest-service/internal/api/http/types"
+)
+
+type WebhookHandler struct {
+	webhookUsecase WebhookUsecase
+}
+
+func NewWebhookHandler(webhookUsecase WebhookUsecase) *WebhookHandler {
+	return &WebhookHandler{webhookUsecase: webhookUsecase}
+}
+
+func (h *WebhookHandler) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	req, err := types.ParseWebhookRequest(r)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	hook, err := h.webhookUsecase.CreateWebhook(r.Context(), req.ToEntity())
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	resp := types.WebhookResponseDTO{
+		Webhook: types.FromEntityWebhook(hook, true),
+	}
+
+	types.WriteJSON(w, http.StatusCreated, resp)
+}
+
+func (h *WebhookHandler) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	hooks, err := h.webhookUsecase.ListWebhooks(r.Context())
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	resp := types.WebhookListResponseDTO{
+		Webhooks: types.FromEntityWebhooks(hooks),
+	}
+
+	types.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *WebhookHandler) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	req, err := types.ParseDeleteWebhookRequest(r)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	if err := h.webhookUsecase.DeleteWebhook(r.Context(), req.ID); err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *WebhookHandler) GetDeliveries(w http.ResponseWriter, r *http.Request) {
+	filter, err := types.ParseDeliveryFilter(r)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	deliveries, err := h.webhookUsecase.GetDeliveries(r.Context(), filter)
+	if err != nil {
+		types.HandleError(w, err)
+		return
+	}
+
+	resp := types.WebhookDeliveriesResponseDTO{
+		WebhookID:  filter.WebhookID,
+		Deliveries: types.FromEntityDeliveries(deliveries),
+	}
+
+	types.WriteJSON(w, http.StatusOK, resp)
+}
@@ -0,0 +1,16 @@
+package router
+
+import (
+	handler "pullrequest-service/internal/api/http/handlers"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func NewAvailabilityRouter(availabilityHandler *handler.AvailabilityHandler) chi.Router {
+	r := chi.NewRouter()
+	r.Post("/add", availabilityHandler.AddUnavailability)
+	r.Get("/list", availabilityHandler.GetUnavailabilities)
+	r.Post("/delete", availabilityHandler.DeleteUnavailability)
+
+	return r
+}
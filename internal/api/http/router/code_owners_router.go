@@ -0,0 +1,16 @@
+package router
+
+import (
+	handler "pullrequest-service/internal/api/http/handlers"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func NewCodeOwnersRouter(codeOwnersHandler *handler.CodeOwnersHandler) chi.Router {
+	r := chi.NewRouter()
+	r.Post("/add", codeOwnersHandler.AddRule)
+	r.Get("/list", codeOwnersHandler.GetRules)
+	r.Post("/delete", codeOwnersHandler.DeleteRule)
+
+	return r
+}
@@ -0,0 +1,15 @@
+package router
+
+import (
+	handler "pullrequest-service/internal/api/http/handlers"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func NewDumpRouter(dumpHandler *handler.DumpHandler) chi.Router {
+	r := chi.NewRouter()
+	r.Get("/export", dumpHandler.Export)
+	r.Post("/import", dumpHandler.Import)
+
+	return r
+}
@@ -0,0 +1,20 @@
+package router
+
+import (
+	handler "pullrequest-service/internal/api/http/handlers"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func NewIntegrationRouter(integrationHandler *handler.IntegrationHandler) chi.Router {
+	r := chi.NewRouter()
+	r.Post("/github", integrationHandler.GitHubWebhook)
+	r.Post("/gitlab", integrationHandler.GitLabWebhook)
+	r.Post("/accounts/set", integrationHandler.SetAccount)
+	r.Get("/accounts/list", integrationHandler.ListAccounts)
+	r.Post("/accounts/delete", integrationHandler.DeleteAccount)
+	r.Get("/unmapped/list", integrationHandler.ListUnmapped)
+	r.Post("/unmapped/dismiss", integrationHandler.DismissUnmapped)
+
+	return r
+}
@@ -0,0 +1,15 @@
+package router
+
+import (
+	handler "pullrequest-service/internal/api/http/handlers"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func NewNotificationRouter(notificationHandler *handler.NotificationHandler) chi.Router {
+	r := chi.NewRouter()
+	r.Post("/setPreferences", notificationHandler.SetPreferences)
+	r.Get("/getPreferences", notificationHandler.GetPreferences)
+
+	return r
+}
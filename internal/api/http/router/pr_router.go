@@ -10,7 +10,16 @@ func NewPRRouter(teamHandler *handler.PRHandler) chi.Router {
 	r := chi.NewRouter()
 	r.Post("/create", teamHandler.CreatePR)
 	r.Post("/merge", teamHandler.MergePR)
+	r.Post("/close", teamHandler.ClosePR)
+	r.Post("/reopen", teamHandler.ReopenPR)
 	r.Post("/reassign", teamHandler.ReAssign)
+	r.Get("/history", teamHandler.GetAssignmentHistory)
+	r.Post("/suggestReviewers", teamHandler.SuggestReviewers)
+	r.Post("/addReviewer", teamHandler.AddReviewer)
+	r.Post("/removeReviewer", teamHandler.RemoveReviewer)
+	r.Post("/decline", teamHandler.Decline)
+	r.Post("/respond", teamHandler.Respond)
+	r.Get("/overdue", teamHandler.GetOverduePRs)
 
 	return r
 }
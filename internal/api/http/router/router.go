@@ -6,12 +6,21 @@ import (
 	"github.com/go-chi/chi/v5"
 )
 
-func NewRouter(teamHandler *handler.TeamHandler, userHandler *handler.UserHandler, prHandler *handler.PRHandler) chi.Router {
+func NewRouter(teamHandler *handler.TeamHandler, userHandler *handler.UserHandler, prHandler *handler.PRHandler, dumpHandler *handler.DumpHandler,
+	availabilityHandler *handler.AvailabilityHandler, codeOwnersHandler *handler.CodeOwnersHandler, webhookHandler *handler.WebhookHandler,
+	integrationHandler *handler.IntegrationHandler, notificationHandler *handler.NotificationHandler, streamHandler *handler.StreamHandler) chi.Router {
 	r := chi.NewRouter()
 
 	r.Mount("/team", NewTeamRouter(teamHandler))
 	r.Mount("/users", NewUserRouter(userHandler))
 	r.Mount("/pullRequest", NewPRRouter(prHandler))
+	r.Mount("/dump", NewDumpRouter(dumpHandler))
+	r.Mount("/availability", NewAvailabilityRouter(availabilityHandler))
+	r.Mount("/codeOwners", NewCodeOwnersRouter(codeOwnersHandler))
+	r.Mount("/webhooks", NewWebhookRouter(webhookHandler))
+	r.Mount("/integrations", NewIntegrationRouter(integrationHandler))
+	r.Mount("/notifications", NewNotificationRouter(notificationHandler))
+	r.Mount("/events", NewStreamRouter(streamHandler))
 
 	return r
 }
@@ -0,0 +1,14 @@
+package router
+
+import (
+	handler "pullrequest-service/internal/api/http/handlers"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func NewStreamRouter(streamHandler *handler.StreamHandler) chi.Router {
+	r := chi.NewRouter()
+	r.Get("/stream", streamHandler.Stream)
+
+	return r
+}
@@ -10,6 +10,12 @@ func NewTeamRouter(teamHandler *handler.TeamHandler) chi.Router {
 	r := chi.NewRouter()
 	r.Post("/add", teamHandler.AddTeam)
 	r.Get("/get", teamHandler.GetTeam)
+	r.Post("/sync", teamHandler.SyncTeam)
+	r.Post("/setDefaultMaxOpenReviews", teamHandler.SetDefaultMaxOpenReviews)
+	r.Post("/setFallbacks", teamHandler.SetFallbackTeams)
+	r.Get("/getFallbacks", teamHandler.GetFallbackTeams)
+	r.Post("/setPolicy", teamHandler.SetPolicy)
+	r.Get("/getPolicy", teamHandler.GetPolicy)
 
 	return r
 }
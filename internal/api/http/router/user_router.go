@@ -10,6 +10,15 @@ func NewUserRouter(userHandler *handler.UserHandler) chi.Router {
 	r := chi.NewRouter()
 	r.Post("/setIsActive", userHandler.SetActive)
 	r.Get("/getReview", userHandler.GetPR)
+	r.Patch("/setUsername", userHandler.SetUsername)
+	r.Get("/get", userHandler.GetUser)
+	r.Get("/list", userHandler.ListUsers)
+	r.Post("/setMaxOpenReviews", userHandler.SetMaxOpenReviews)
+	r.Post("/setTags", userHandler.SetTags)
+	r.Post("/setSeniority", userHandler.SetSeniority)
+	r.Post("/setWorkingHours", userHandler.SetWorkingHours)
+	r.Post("/setEmail", userHandler.SetEmail)
+	r.Get("/getStats", userHandler.GetReviewerStats)
 
 	return r
 }
@@ -0,0 +1,17 @@
+package router
+
+import (
+	handler "pullrequest-service/internal/api/http/handlers"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func NewWebhookRouter(webhookHandler *handler.WebhookHandler) chi.Router {
+	r := chi.NewRouter()
+	r.Post("/create", webhookHandler.CreateWebhook)
+	r.Get("/list", webhookHandler.ListWebhooks)
+	r.Post("/delete", webhookHandler.DeleteWebhook)
+	r.Get("/deliveries", webhookHandler.GetDeliveries)
+
+	return r
+}
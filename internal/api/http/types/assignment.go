@@ -0,0 +1,79 @@
+package types
+
+import (
+	"pullrequest-service/internal/entity"
+	"time"
+)
+
+type ExcludedCandidateDTO struct {
+	UserID string                 `json:"user_id"`
+	Reason entity.ExclusionReason `json:"reason"`
+}
+
+type SelectedReviewerDTO struct {
+	UserID   string                 `json:"user_id"`
+	TeamName string                 `json:"team_name"`
+	Reason   entity.SelectionReason `json:"reason"`
+	Score    int                    `json:"score"`
+	AtWork   bool                   `json:"at_work"`
+}
+
+type SelectionExplanationDTO struct {
+	PoolSize  int                    `json:"pool_size"`
+	Excluded  []ExcludedCandidateDTO `json:"excluded"`
+	Reviewers []SelectedReviewerDTO  `json:"reviewers"`
+}
+
+type AssignmentRecordDTO struct {
+	ID            int64                   `json:"id"`
+	Action        entity.AssignmentAction `json:"action"`
+	ReviewerIDs   []string                `json:"reviewer_ids"`
+	OldReviewerID string                  `json:"old_reviewer_id,omitempty"`
+	Reason        string                  `json:"reason,omitempty"`
+	Explanation   SelectionExplanationDTO `json:"explanation"`
+	CreatedAt     time.Time               `json:"created_at"`
+}
+
+type AssignmentHistoryResponseDTO struct {
+	PullRequestID string                `json:"pull_request_id"`
+	History       []AssignmentRecordDTO `json:"history"`
+}
+
+func fromEntityExplanation(e *entity.SelectionExplanation) *SelectionExplanationDTO {
+	if e == nil {
+		return nil
+	}
+
+	res := &SelectionExplanationDTO{
+		PoolSize:  e.PoolSize,
+		Excluded:  make([]ExcludedCandidateDTO, len(e.Excluded)),
+		Reviewers: make([]SelectedReviewerDTO, len(e.Reviewers)),
+	}
+
+	for i, c := range e.Excluded {
+		res.Excluded[i] = ExcludedCandidateDTO{UserID: c.UserID, Reason: c.Reason}
+	}
+
+	for i, a := range e.Reviewers {
+		res.Reviewers[i] = SelectedReviewerDTO{UserID: a.UserID, TeamName: a.TeamName, Reason: a.Reason, Score: a.Score, AtWork: a.AtWork}
+	}
+
+	return res
+}
+
+func FromEntityAssignmentHistory(history []entity.AssignmentRecord) []AssignmentRecordDTO {
+	res := make([]AssignmentRecordDTO, len(history))
+	for i := range history {
+		record := &history[i]
+		res[i] = AssignmentRecordDTO{
+			ID:            record.ID,
+			Action:        record.Action,
+			ReviewerIDs:   append([]string{}, record.ReviewerIDs...),
+			OldReviewerID: record.OldReviewerID,
+			Reason:        record.Reason,
+			Explanation:   *fromEntityExplanation(&record.Explanation),
+			CreatedAt:     record.CreatedAt,
+		}
+	}
+	return res
+}
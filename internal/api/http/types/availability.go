@@ -0,0 +1,89 @@
+package types
+
+import (
+	"encoding/json"
+	"net/http"
+	"pullrequest-service/internal/entity"
+	"time"
+)
+
+type UnavailabilityRequestDTO struct {
+	UserID          string    `json:"user_id"`
+	StartsAt        time.Time `json:"starts_at"`
+	EndsAt          time.Time `json:"ends_at"`
+	Reason          string    `json:"reason"`
+	ReassignReviews bool      `json:"reassign_reviews"`
+}
+
+type UnavailabilityDTO struct {
+	ID                  int64      `json:"id"`
+	UserID              string     `json:"user_id"`
+	StartsAt            time.Time  `json:"starts_at"`
+	EndsAt              time.Time  `json:"ends_at"`
+	Reason              string     `json:"reason"`
+	ReassignReviews     bool       `json:"reassign_reviews"`
+	ReviewsReassignedAt *time.Time `json:"reviews_reassigned_at,omitempty"`
+}
+
+type UnavailabilityResponseDTO struct {
+	Unavailability UnavailabilityDTO `json:"unavailability"`
+}
+
+type UnavailabilityListResponseDTO struct {
+	UserID         string              `json:"user_id"`
+	Unavailability []UnavailabilityDTO `json:"unavailability"`
+}
+
+type DeleteUnavailabilityRequestDTO struct {
+	ID int64 `json:"id"`
+}
+
+func ParseUnavailabilityRequest(r *http.Request) (*UnavailabilityRequestDTO, error) {
+	var req UnavailabilityRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+
+	return &req, nil
+}
+
+func ParseDeleteUnavailabilityRequest(r *http.Request) (*DeleteUnavailabilityRequestDTO, error) {
+	var req DeleteUnavailabilityRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+
+	return &req, nil
+}
+
+func (req *UnavailabilityRequestDTO) ToEntity() *entity.Unavailability {
+	return &entity.Unavailability{
+		UserID:          req.UserID,
+		StartsAt:        req.StartsAt,
+		EndsAt:          req.EndsAt,
+		Reason:          req.Reason,
+		ReassignReviews: req.ReassignReviews,
+	}
+}
+
+func FromEntityUnavailability(u *entity.Unavailability) UnavailabilityDTO {
+	return UnavailabilityDTO{
+		ID:                  u.ID,
+		UserID:              u.UserID,
+		StartsAt:            u.StartsAt,
+		EndsAt:              u.EndsAt,
+		Reason:              u.Reason,
+		ReassignReviews:     u.ReassignReviews,
+		ReviewsReassignedAt: u.ReviewsReassignedAt,
+	}
+}
+
+func FromEntityUnavailabilities(windows []entity.Unavailability) []UnavailabilityDTO {
+	res := make([]UnavailabilityDTO, len(windows))
+	for i := range windows {
+		res[i] = FromEntityUnavailability(&windows[i])
+	}
+	return res
+}
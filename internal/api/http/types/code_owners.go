@@ -0,0 +1,75 @@
+package types
+
+import (
+	"encoding/json"
+	"net/http"
+	"pullrequest-service/internal/entity"
+)
+
+type CodeOwnerRuleDTO struct {
+	ID         int64    `json:"id,omitempty"`
+	TeamName   string   `json:"team_name"`
+	Pattern    string   `json:"pattern"`
+	OwnerUsers []string `json:"owner_users"`
+	OwnerTeams []string `json:"owner_teams"`
+}
+
+type CodeOwnerRuleResponseDTO struct {
+	Rule CodeOwnerRuleDTO `json:"rule"`
+}
+
+type CodeOwnerRulesResponseDTO struct {
+	TeamName string             `json:"team_name"`
+	Rules    []CodeOwnerRuleDTO `json:"rules"`
+}
+
+type DeleteCodeOwnerRuleRequestDTO struct {
+	ID int64 `json:"id"`
+}
+
+func ParseCodeOwnerRuleRequest(r *http.Request) (*CodeOwnerRuleDTO, error) {
+	var req CodeOwnerRuleDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+
+	return &req, nil
+}
+
+func ParseDeleteCodeOwnerRuleRequest(r *http.Request) (*DeleteCodeOwnerRuleRequestDTO, error) {
+	var req DeleteCodeOwnerRuleRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+
+	return &req, nil
+}
+
+func (req *CodeOwnerRuleDTO) ToEntity() *entity.CodeOwnerRule {
+	return &entity.CodeOwnerRule{
+		TeamName:   req.TeamName,
+		Pattern:    req.Pattern,
+		OwnerUsers: append([]string{}, req.OwnerUsers...),
+		OwnerTeams: append([]string{}, req.OwnerTeams...),
+	}
+}
+
+func FromEntityCodeOwnerRule(rule *entity.CodeOwnerRule) CodeOwnerRuleDTO {
+	return CodeOwnerRuleDTO{
+		ID:         rule.ID,
+		TeamName:   rule.TeamName,
+		Pattern:    rule.Pattern,
+		OwnerUsers: rule.OwnerUsers,
+		OwnerTeams: rule.OwnerTeams,
+	}
+}
+
+func FromEntityCodeOwnerRules(rules []entity.CodeOwnerRule) []CodeOwnerRuleDTO {
+	res := make([]CodeOwnerRuleDTO, len(rules))
+	for i := range rules {
+		res[i] = FromEntityCodeOwnerRule(&rules[i])
+	}
+	return res
+}
@@ -33,6 +33,16 @@ func HandleError(w http.ResponseWriter, err error) {
 	status := http.StatusInternalServerError
 
 	switch {
+	case errors.Is(err, entity.ErrInvalidRequest):
+		status = http.StatusBadRequest
+		resp.Err.Code = entity.CodeInvalidReq
+		resp.Err.Message = err.Error()
+
+	case errors.Is(err, entity.ErrUnauthorized):
+		status = http.StatusUnauthorized
+		resp.Err.Code = entity.CodeUnauthorized
+		resp.Err.Message = entity.ErrUnauthorized.Error()
+
 	case errors.Is(err, entity.ErrPRExists):
 		status = http.StatusConflict
 		resp.Err.Code = entity.CodePRExists
@@ -48,6 +58,11 @@ func HandleError(w http.ResponseWriter, err error) {
 		resp.Err.Code = entity.CodePRMerged
 		resp.Err.Message = entity.ErrPRMerged.Error()
 
+	case errors.Is(err, entity.ErrPRClosed):
+		status = http.StatusConflict
+		resp.Err.Code = entity.CodePRClosed
+		resp.Err.Message = entity.ErrPRClosed.Error()
+
 	case errors.Is(err, entity.ErrTeamExists):
 		status = http.StatusConflict
 		resp.Err.Code = entity.CodeTeamExists
@@ -58,6 +73,21 @@ func HandleError(w http.ResponseWriter, err error) {
 		resp.Err.Code = entity.CodeUserInAnotherTeam
 		resp.Err.Message = entity.ErrUserInAnotherTeam.Error()
 
+	case errors.Is(err, entity.ErrUserIsAuthor):
+		status = http.StatusConflict
+		resp.Err.Code = entity.CodeUserIsAuthor
+		resp.Err.Message = entity.ErrUserIsAuthor.Error()
+
+	case errors.Is(err, entity.ErrImportConflict):
+		status = http.StatusConflict
+		resp.Err.Code = entity.CodeImportConflict
+		resp.Err.Message = entity.ErrImportConflict.Error()
+
+	case errors.Is(err, entity.ErrAssigned):
+		status = http.StatusConflict
+		resp.Err.Code = entity.CodeAssigned
+		resp.Err.Message = entity.ErrAssigned.Error()
+
 	case errors.Is(err, entity.ErrNoCandidate):
 		status = http.StatusConflict
 		resp.Err.Code = entity.CodeNoCandidate
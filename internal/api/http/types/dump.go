@@ -0,0 +1,39 @@
+package types
+
+import (
+	"net/http"
+	"pullrequest-service/internal/entity"
+)
+
+type ImportStatsDTO struct {
+	Created int `json:"created"`
+	Updated int `json:"updated"`
+	Skipped int `json:"skipped"`
+}
+
+type ImportResponseDTO struct {
+	Teams        ImportStatsDTO `json:"teams"`
+	Users        ImportStatsDTO `json:"users"`
+	PullRequests ImportStatsDTO `json:"pull_requests"`
+}
+
+func ParseConflictPolicy(r *http.Request) (entity.ConflictPolicy, error) {
+	policy := entity.ConflictPolicy(r.URL.Query().Get("policy"))
+	if policy == "" {
+		return entity.ConflictFail, nil
+	}
+
+	if err := policy.Validate(); err != nil {
+		return "", err
+	}
+
+	return policy, nil
+}
+
+func FromEntityImportResult(res *entity.ImportResult) ImportResponseDTO {
+	return ImportResponseDTO{
+		Teams:        ImportStatsDTO(res.Teams),
+		Users:        ImportStatsDTO(res.Users),
+		PullRequests: ImportStatsDTO(res.PullRequests),
+	}
+}
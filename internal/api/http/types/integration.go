@@ -0,0 +1,122 @@
+package types
+
+import (
+	"encoding/json"
+	"net/http"
+	"pullrequest-service/internal/entity"
+	"time"
+)
+
+type ExternalAccountDTO struct {
+	Provider entity.Provider `json:"provider"`
+	Login    string          `json:"login"`
+	UserID   string          `json:"user_id"`
+}
+
+type ExternalAccountResponseDTO struct {
+	Account ExternalAccountDTO `json:"account"`
+}
+
+type ExternalAccountListResponseDTO struct {
+	Accounts []ExternalAccountDTO `json:"accounts"`
+}
+
+type DeleteExternalAccountRequestDTO struct {
+	Provider entity.Provider `json:"provider"`
+	Login    string          `json:"login"`
+}
+
+type IngestResultDTO struct {
+	Status      entity.IngestStatus `json:"status"`
+	Reason      string              `json:"reason,omitempty"`
+	PullRequest *PrDTO              `json:"pr,omitempty"`
+}
+
+type UnmappedEventDTO struct {
+	ID            int64                   `json:"id"`
+	Provider      entity.Provider         `json:"provider"`
+	Login         string                  `json:"login"`
+	Action        entity.ExternalPRAction `json:"action"`
+	PullRequestID string                  `json:"pull_request_id"`
+	Title         string                  `json:"title,omitempty"`
+	CreatedAt     time.Time               `json:"created_at"`
+}
+
+type UnmappedEventListResponseDTO struct {
+	Events []UnmappedEventDTO `json:"events"`
+}
+
+type DismissUnmappedRequestDTO struct {
+	ID int64 `json:"id"`
+}
+
+func ParseExternalAccountRequest(r *http.Request) (*ExternalAccountDTO, error) {
+	var req ExternalAccountDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+
+	return &req, nil
+}
+
+func ParseDeleteExternalAccountRequest(r *http.Request) (*DeleteExternalAccountRequestDTO, error) {
+	var req DeleteExternalAccountRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+
+	return &req, nil
+}
+
+func ParseDismissUnmappedRequest(r *http.Request) (*DismissUnmappedRequestDTO, error) {
+	var req DismissUnmappedRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+
+	return &req, nil
+}
+
+func (req *ExternalAccountDTO) ToEntity() *entity.ExternalAccount {
+	return &entity.ExternalAccount{Provider: req.Provider, Login: req.Login, UserID: req.UserID}
+}
+
+func FromEntityAccount(account *entity.ExternalAccount) ExternalAccountDTO {
+	return ExternalAccountDTO{Provider: account.Provider, Login: account.Login, UserID: account.UserID}
+}
+
+func FromEntityAccounts(accounts []entity.ExternalAccount) []ExternalAccountDTO {
+	res := make([]ExternalAccountDTO, len(accounts))
+	for i := range accounts {
+		res[i] = FromEntityAccount(&accounts[i])
+	}
+	return res
+}
+
+func FromEntityIngestResult(result *entity.IngestResult) IngestResultDTO {
+	dto := IngestResultDTO{Status: result.Status, Reason: result.Reason}
+	if result.PullRequest != nil {
+		pr := FromEntityPR(result.PullRequest)
+		dto.PullRequest = &pr
+	}
+	return dto
+}
+
+func FromEntityUnmappedEvents(events []entity.UnmappedEvent) []UnmappedEventDTO {
+	res := make([]UnmappedEventDTO, len(events))
+	for i, e := range events {
+		res[i] = UnmappedEventDTO{
+			ID:            e.ID,
+			Provider:      e.Event.Provider,
+			Login:         e.Login,
+			Action:        e.Event.Action,
+			PullRequestID: e.Event.PullRequestID,
+			Title:         e.Event.Title,
+			CreatedAt:     e.CreatedAt,
+		}
+	}
+	return res
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"encoding/json"
+	"net/http"
+	"pullrequest-service/internal/entity"
+)
+
+type ChatChannelDTO struct {
+	Kind    entity.ChatKind `json:"kind"`
+	Channel string          `json:"channel,omitempty"`
+}
+
+type NotificationPreferencesDTO struct {
+	UserID     string           `json:"user_id"`
+	Channels   []ChatChannelDTO `json:"channels"`
+	Mute       bool             `json:"mute"`
+	DigestOnly bool             `json:"digest_only"`
+}
+
+type NotificationPreferencesResponseDTO struct {
+	Preferences NotificationPreferencesDTO `json:"preferences"`
+}
+
+func ParseNotificationPreferencesRequest(r *http.Request) (*NotificationPreferencesDTO, error) {
+	var req NotificationPreferencesDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+
+	return &req, nil
+}
+
+func (r *NotificationPreferencesDTO) ToEntity() *entity.NotificationPreferences {
+	channels := make([]entity.ChatChannel, len(r.Channels))
+	for i, channel := range r.Channels {
+		channels[i] = entity.ChatChannel{Kind: channel.Kind, Channel: channel.Channel}
+	}
+
+	return &entity.NotificationPreferences{UserID: r.UserID, Channels: channels, Mute: r.Mute, DigestOnly: r.DigestOnly}
+}
+
+func FromEntityNotificationPreferences(prefs *entity.NotificationPreferences) NotificationPreferencesDTO {
+	channels := make([]ChatChannelDTO, len(prefs.Channels))
+	for i, channel := range prefs.Channels {
+		channels[i] = ChatChannelDTO{Kind: channel.Kind, Channel: channel.Channel}
+	}
+
+	return NotificationPreferencesDTO{UserID: prefs.UserID, Channels: channels, Mute: prefs.Mute, DigestOnly: prefs.DigestOnly}
+}
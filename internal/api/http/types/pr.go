@@ -2,25 +2,53 @@ package types
 
 import (
 	"encoding/json"
+	"math"
 	"net/http"
 	"pullrequest-service/internal/entity"
 	"time"
 )
 
 type CreatePrRequest struct {
-	PullRequestID   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
-	AuthorID        string `json:"author_id"`
+	PullRequestID   string   `json:"pull_request_id"`
+	PullRequestName string   `json:"pull_request_name"`
+	AuthorID        string   `json:"author_id"`
+	ChangedFiles    []string `json:"changed_files"`
+	Tags            []string `json:"tags"`
+}
+
+type SuggestReviewersRequest struct {
+	AuthorID     string   `json:"author_id"`
+	ChangedFiles []string `json:"changed_files"`
+	Tags         []string `json:"tags"`
+}
+
+type SuggestReviewersResponse struct {
+	AuthorID    string                  `json:"author_id"`
+	Reviewers   []SelectedReviewerDTO   `json:"reviewers"`
+	Warnings    []string                `json:"warnings,omitempty"`
+	Explanation SelectionExplanationDTO `json:"explanation"`
+}
+
+type FallbackReviewerDTO struct {
+	UserID   string `json:"user_id"`
+	TeamName string `json:"team_name"`
 }
 
 type PrDTO struct {
-	PullRequestID     string        `json:"pull_request_id"`
-	PullRequestName   string        `json:"pull_request_name"`
-	AuthorID          string        `json:"author_id"`
-	Status            entity.Status `json:"status"`
-	AssignedReviewers []string      `json:"assigned_reviewers"`
-	CreatedAt         *time.Time    `json:"created_at,omitempty"`
-	MergedAt          *time.Time    `json:"merged_at,omitempty"`
+	PullRequestID     string                   `json:"pull_request_id"`
+	PullRequestName   string                   `json:"pull_request_name"`
+	AuthorID          string                   `json:"author_id"`
+	Status            entity.Status            `json:"status"`
+	AssignedReviewers []string                 `json:"assigned_reviewers"`
+	CreatedAt         *time.Time               `json:"created_at,omitempty"`
+	MergedAt          *time.Time               `json:"merged_at,omitempty"`
+	Tags              []string                 `json:"tags,omitempty"`
+	Warnings          []string                 `json:"warnings,omitempty"`
+	FallbackReviewers []FallbackReviewerDTO    `json:"fallback_reviewers,omitempty"`
+	Explanation       *SelectionExplanationDTO `json:"explanation,omitempty"`
+	Overdue           bool                     `json:"overdue"`
+	OverdueReviewers  []string                 `json:"overdue_reviewers,omitempty"`
+	ReviewAgeHours    map[string]float64       `json:"review_age_hours,omitempty"`
 }
 
 type CreatePrResponse struct {
@@ -34,6 +62,22 @@ type MergeRequest struct {
 type ReAssignRequest struct {
 	PullRequestID string `json:"pull_request_id"`
 	OldReviewerId string `json:"old_reviewer_id"`
+	NewReviewerId string `json:"new_reviewer_id"`
+}
+
+type DeclineRequest struct {
+	PullRequestID string `json:"pull_request_id"`
+	ReviewerID    string `json:"reviewer_id"`
+	Reason        string `json:"reason"`
+}
+
+type ReviewerRequest struct {
+	PullRequestID string `json:"pull_request_id"`
+	ReviewerID    string `json:"reviewer_id"`
+}
+
+type OverduePRsResponse struct {
+	PullRequests []PrDTO `json:"pull_requests"`
 }
 
 type ReAssignResponse struct {
@@ -51,6 +95,16 @@ func ParseCreatePrRequest(r *http.Request) (*CreatePrRequest, error) {
 	return &req, nil
 }
 
+func ParseSuggestReviewersRequest(r *http.Request) (*SuggestReviewersRequest, error) {
+	var req SuggestReviewersRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+
+	return &req, nil
+}
+
 func ParseMergeRequest(r *http.Request) (*MergeRequest, error) {
 	var req MergeRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -61,6 +115,26 @@ func ParseMergeRequest(r *http.Request) (*MergeRequest, error) {
 	return &req, nil
 }
 
+func ParseReviewerRequest(r *http.Request) (*ReviewerRequest, error) {
+	var req ReviewerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+
+	return &req, nil
+}
+
+func ParseDeclineRequest(r *http.Request) (*DeclineRequest, error) {
+	var req DeclineRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+
+	return &req, nil
+}
+
 func ParseReAssignRequest(r *http.Request) (*ReAssignRequest, error) {
 	var req ReAssignRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -71,7 +145,22 @@ func ParseReAssignRequest(r *http.Request) (*ReAssignRequest, error) {
 	return &req, nil
 }
 
+func (req *CreatePrRequest) ToEntity() *entity.CreatePRRequest {
+	return &entity.CreatePRRequest{
+		PullRequestID:   req.PullRequestID,
+		PullRequestName: req.PullRequestName,
+		AuthorID:        req.AuthorID,
+		ChangedFiles:    req.ChangedFiles,
+		Tags:            req.Tags,
+	}
+}
+
 func FromEntityPR(pr *entity.PullRequest) PrDTO {
+	var fallbackReviewers []FallbackReviewerDTO
+	for _, a := range pr.FallbackReviewers {
+		fallbackReviewers = append(fallbackReviewers, FallbackReviewerDTO{UserID: a.UserID, TeamName: a.TeamName})
+	}
+
 	return PrDTO{
 		PullRequestID:     pr.PullRequestID,
 		PullRequestName:   pr.PullRequestName,
@@ -80,5 +169,51 @@ func FromEntityPR(pr *entity.PullRequest) PrDTO {
 		AssignedReviewers: pr.AssignedReviewers,
 		CreatedAt:         pr.CreatedAt,
 		MergedAt:          pr.MergedAt,
+		Tags:              pr.Tags,
+		Warnings:          pr.Warnings,
+		FallbackReviewers: fallbackReviewers,
+		Explanation:       fromEntityExplanation(pr.Explanation),
+		Overdue:           len(pr.OverdueReviewers) > 0,
+		OverdueReviewers:  pr.OverdueReviewers,
+		ReviewAgeHours:    fromEntityReviewAges(pr.ReviewAges),
+	}
+}
+
+func fromEntityReviewAges(ages map[string]time.Duration) map[string]float64 {
+	if len(ages) == 0 {
+		return nil
+	}
+
+	res := make(map[string]float64, len(ages))
+	for userId, age := range ages {
+		res[userId] = math.Round(age.Hours()*100) / 100
+	}
+	return res
+}
+
+func (req *SuggestReviewersRequest) ToEntity() *entity.SuggestReviewersRequest {
+	return &entity.SuggestReviewersRequest{
+		AuthorID:     req.AuthorID,
+		ChangedFiles: req.ChangedFiles,
+		Tags:         req.Tags,
+	}
+}
+
+func FromEntitySuggestion(authorId string, selection *entity.ReviewerSelection) SuggestReviewersResponse {
+	explanation := fromEntityExplanation(&selection.Explanation)
+
+	return SuggestReviewersResponse{
+		AuthorID:    authorId,
+		Reviewers:   explanation.Reviewers,
+		Warnings:    selection.Warnings,
+		Explanation: *explanation,
+	}
+}
+
+func FromEntityOverduePRs(prs []entity.PullRequest) OverduePRsResponse {
+	resp := OverduePRsResponse{PullRequests: make([]PrDTO, 0, len(prs))}
+	for i := range prs {
+		resp.PullRequests = append(resp.PullRequests, FromEntityPR(&prs[i]))
 	}
+	return resp
 }
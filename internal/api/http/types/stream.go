@@ -0,0 +1,18 @@
+package types
+
+import (
+	"fmt"
+	"io"
+	"pullrequest-service/internal/entity"
+	"pullrequest-service/internal/events"
+)
+
+func WriteSSEEvent(w io.Writer, event *entity.Event) error {
+	data, err := events.Encode(event)
+	if err != nil {
+		return fmt.Errorf("encode event: %w", err)
+	}
+
+	_, err = fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+	return err
+}
@@ -2,8 +2,10 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"pullrequest-service/internal/entity"
+	"strconv"
 )
 
 type TeamRequestDTO struct {
@@ -12,15 +14,51 @@ type TeamRequestDTO struct {
 }
 
 type TeamMemberDTO struct {
-	UserID   string `json:"user_id"`
-	UserName string `json:"username"`
-	IsActive bool   `json:"is_active"`
+	UserID    string           `json:"user_id"`
+	UserName  string           `json:"username"`
+	IsActive  bool             `json:"is_active"`
+	Tags      []string         `json:"tags"`
+	Seniority entity.Seniority `json:"seniority,omitempty"`
 }
 
 type TeamResponseDTO struct {
 	Team TeamRequestDTO `json:"team"`
 }
 
+type SetDefaultMaxOpenReviewsRequestDTO struct {
+	TeamName              string `json:"team_name"`
+	DefaultMaxOpenReviews *int   `json:"default_max_open_reviews"`
+}
+
+type FallbackTeamsDTO struct {
+	TeamName      string   `json:"team_name"`
+	FallbackTeams []string `json:"fallback_teams"`
+}
+
+type TeamPolicyDTO struct {
+	TeamName            string           `json:"team_name"`
+	MentorshipRequired  bool             `json:"mentorship_required"`
+	RepeatPairingWindow int              `json:"repeat_pairing_window"`
+	ReviewSLAHours      int              `json:"review_sla_hours"`
+	SLAAction           entity.SLAAction `json:"sla_action"`
+}
+
+type ReviewerChangeDTO struct {
+	PullRequestID string `json:"pull_request_id"`
+	OldReviewerID string `json:"old_reviewer_id"`
+	NewReviewerID string `json:"new_reviewer_id,omitempty"`
+}
+
+type TeamSyncResponseDTO struct {
+	TeamName    string              `json:"team_name"`
+	DryRun      bool                `json:"dry_run"`
+	TeamCreated bool                `json:"team_created"`
+	Created     []TeamMemberDTO     `json:"created"`
+	Updated     []TeamMemberDTO     `json:"updated"`
+	Removed     []string            `json:"removed"`
+	Reassigned  []ReviewerChangeDTO `json:"reassigned"`
+}
+
 func ParseTeamRequestDTO(r *http.Request) (*TeamRequestDTO, error) {
 	var req TeamRequestDTO
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -31,18 +69,127 @@ func ParseTeamRequestDTO(r *http.Request) (*TeamRequestDTO, error) {
 	return &req, nil
 }
 
-func FromEntityTeam(t *entity.Team) TeamRequestDTO {
-	members := make([]TeamMemberDTO, len(t.Members))
+func ParseSetDefaultMaxOpenReviewsRequest(r *http.Request) (*SetDefaultMaxOpenReviewsRequestDTO, error) {
+	var req SetDefaultMaxOpenReviewsRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+
+	return &req, nil
+}
+
+func ParseFallbackTeamsRequest(r *http.Request) (*FallbackTeamsDTO, error) {
+	var req FallbackTeamsDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+
+	return &req, nil
+}
+
+func ParseTeamPolicyRequest(r *http.Request) (*TeamPolicyDTO, error) {
+	var req TeamPolicyDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+
+	return &req, nil
+}
+
+func ParseDryRun(r *http.Request) (bool, error) {
+	raw := r.URL.Query().Get("dry_run")
+	if raw == "" {
+		return false, nil
+	}
+
+	dryRun, err := strconv.ParseBool(raw)
+	if err != nil {
+		return false, fmt.Errorf("%w: invalid dry_run", entity.ErrInvalidRequest)
+	}
+
+	return dryRun, nil
+}
+
+func (t *TeamRequestDTO) ToEntity() *entity.Team {
+	team := &entity.Team{
+		TeamName: t.TeamName,
+		Members:  make([]entity.TeamMember, len(t.Members)),
+	}
+
 	for i, m := range t.Members {
-		members[i] = TeamMemberDTO{
-			UserID:   m.UserID,
-			UserName: m.UserName,
-			IsActive: m.IsActive,
+		team.Members[i] = entity.TeamMember{
+			UserID:    m.UserID,
+			UserName:  m.UserName,
+			IsActive:  m.IsActive,
+			Tags:      m.Tags,
+			Seniority: m.Seniority,
 		}
 	}
 
+	return team
+}
+
+func FromEntityTeam(t *entity.Team) TeamRequestDTO {
 	return TeamRequestDTO{
 		TeamName: t.TeamName,
-		Members:  members,
+		Members:  fromEntityMembers(t.Members),
+	}
+}
+
+func FromEntityTeamSyncPlan(plan *entity.TeamSyncPlan) TeamSyncResponseDTO {
+	resp := TeamSyncResponseDTO{
+		TeamName:    plan.TeamName,
+		DryRun:      plan.DryRun,
+		TeamCreated: plan.TeamCreated,
+		Created:     fromEntityMembers(plan.Created),
+		Updated:     fromEntityMembers(plan.Updated),
+		Removed:     append([]string{}, plan.Removed...),
+		Reassigned:  make([]ReviewerChangeDTO, len(plan.Reassigned)),
+	}
+
+	for i, c := range plan.Reassigned {
+		resp.Reassigned[i] = ReviewerChangeDTO{
+			PullRequestID: c.PullRequestID,
+			OldReviewerID: c.OldReviewerID,
+			NewReviewerID: c.NewReviewerID,
+		}
+	}
+
+	return resp
+}
+
+func fromEntityMembers(members []entity.TeamMember) []TeamMemberDTO {
+	res := make([]TeamMemberDTO, len(members))
+	for i, m := range members {
+		res[i] = TeamMemberDTO{
+			UserID:    m.UserID,
+			UserName:  m.UserName,
+			IsActive:  m.IsActive,
+			Tags:      fromEntityTags(m.Tags),
+			Seniority: m.Seniority,
+		}
+	}
+	return res
+}
+
+func (p *TeamPolicyDTO) ToEntity() *entity.TeamPolicy {
+	return &entity.TeamPolicy{
+		MentorshipRequired:  p.MentorshipRequired,
+		RepeatPairingWindow: p.RepeatPairingWindow,
+		ReviewSLAHours:      p.ReviewSLAHours,
+		SLAAction:           p.SLAAction,
+	}
+}
+
+func FromEntityTeamPolicy(teamName string, policy *entity.TeamPolicy) TeamPolicyDTO {
+	return TeamPolicyDTO{
+		TeamName:            teamName,
+		MentorshipRequired:  policy.MentorshipRequired,
+		RepeatPairingWindow: policy.RepeatPairingWindow,
+		ReviewSLAHours:      policy.ReviewSLAHours,
+		SLAAction:           policy.SLAAction,
 	}
 }
@@ -2,8 +2,10 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"pullrequest-service/internal/entity"
+	"strconv"
 )
 
 type SetActiveRequestDTO struct {
@@ -11,17 +13,69 @@ type SetActiveRequestDTO struct {
 	IsActive bool   `json:"is_active"`
 }
 
-type SetActiveDTO struct {
-	UserID   string `json:"user_id"`
+type SetUsernameRequestDTO struct {
+	UserId   string `json:"user_id"`
 	UserName string `json:"username"`
-	TeamName string `json:"team_name"`
-	IsActive bool   `json:"is_active"`
+}
+
+type SetTagsRequestDTO struct {
+	UserId string   `json:"user_id"`
+	Tags   []string `json:"tags"`
+}
+
+type SetSeniorityRequestDTO struct {
+	UserId    string           `json:"user_id"`
+	Seniority entity.Seniority `json:"seniority"`
+}
+
+type SetWorkingHoursRequestDTO struct {
+	UserId    string `json:"user_id"`
+	Timezone  string `json:"timezone"`
+	WorkStart string `json:"work_start"`
+	WorkEnd   string `json:"work_end"`
+}
+
+type SetEmailRequestDTO struct {
+	UserId string `json:"user_id"`
+	Email  string `json:"email"`
+}
+
+type SetMaxOpenReviewsRequestDTO struct {
+	UserId         string `json:"user_id"`
+	MaxOpenReviews *int   `json:"max_open_reviews"`
+}
+
+type SetActiveDTO struct {
+	UserID         string           `json:"user_id"`
+	UserName       string           `json:"username"`
+	TeamName       string           `json:"team_name"`
+	IsActive       bool             `json:"is_active"`
+	MaxOpenReviews *int             `json:"max_open_reviews,omitempty"`
+	Tags           []string         `json:"tags"`
+	Seniority      entity.Seniority `json:"seniority"`
+	Timezone       string           `json:"timezone"`
+	WorkStart      string           `json:"work_start"`
+	WorkEnd        string           `json:"work_end"`
+	Email          string           `json:"email,omitempty"`
 }
 
 type SetActiveResponseDTO struct {
 	User SetActiveDTO `json:"user"`
 }
 
+type UsersListResponseDTO struct {
+	Users  []SetActiveDTO `json:"users"`
+	Limit  uint64         `json:"limit"`
+	Offset uint64         `json:"offset"`
+}
+
+type ReviewerStatsDTO struct {
+	UserID       string `json:"user_id"`
+	OpenReviews  int    `json:"open_reviews"`
+	TotalReviews int    `json:"total_reviews"`
+	Declined     int    `json:"declined"`
+}
+
 type PullRequestShortDTO struct {
 	PullRequestID   string `json:"pull_request_id"`
 	PullRequestName string `json:"pull_request_name"`
@@ -44,13 +98,134 @@ func ParseSetActiveRequest(r *http.Request) (*SetActiveRequestDTO, error) {
 	return &req, nil
 }
 
+func ParseSetUsernameRequest(r *http.Request) (*SetUsernameRequestDTO, error) {
+	var req SetUsernameRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+
+	return &req, nil
+}
+
+func ParseSetMaxOpenReviewsRequest(r *http.Request) (*SetMaxOpenReviewsRequestDTO, error) {
+	var req SetMaxOpenReviewsRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+
+	return &req, nil
+}
+
+func ParseSetTagsRequest(r *http.Request) (*SetTagsRequestDTO, error) {
+	var req SetTagsRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+
+	return &req, nil
+}
+
+func ParseSetSeniorityRequest(r *http.Request) (*SetSeniorityRequestDTO, error) {
+	var req SetSeniorityRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+
+	return &req, nil
+}
+
+func ParseSetWorkingHoursRequest(r *http.Request) (*SetWorkingHoursRequestDTO, error) {
+	var req SetWorkingHoursRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+
+	return &req, nil
+}
+
+func ParseSetEmailRequest(r *http.Request) (*SetEmailRequestDTO, error) {
+	var req SetEmailRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+
+	return &req, nil
+}
+
+func (req *SetWorkingHoursRequestDTO) ToEntity() entity.WorkingHours {
+	return entity.WorkingHours{Timezone: req.Timezone, Start: req.WorkStart, End: req.WorkEnd}
+}
+
+func ParseUserFilter(r *http.Request) (*entity.UserFilter, error) {
+	query := r.URL.Query()
+	filter := &entity.UserFilter{}
+
+	if teamName := query.Get("team_name"); teamName != "" {
+		filter.TeamName = &teamName
+	}
+
+	if raw := query.Get("is_active"); raw != "" {
+		isActive, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid is_active", entity.ErrInvalidRequest)
+		}
+		filter.IsActive = &isActive
+	}
+
+	if raw := query.Get("limit"); raw != "" {
+		limit, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid limit", entity.ErrInvalidRequest)
+		}
+		filter.Limit = limit
+	}
+
+	if raw := query.Get("offset"); raw != "" {
+		offset, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid offset", entity.ErrInvalidRequest)
+		}
+		filter.Offset = offset
+	}
+
+	return filter, nil
+}
+
 func FromEntityUser(user *entity.User) SetActiveDTO {
 	return SetActiveDTO{
-		UserID:   user.UserID,
-		UserName: user.UserName,
-		TeamName: user.TeamName,
-		IsActive: user.IsActive,
+		UserID:         user.UserID,
+		UserName:       user.UserName,
+		TeamName:       user.TeamName,
+		IsActive:       user.IsActive,
+		MaxOpenReviews: user.MaxOpenReviews,
+		Tags:           fromEntityTags(user.Tags),
+		Seniority:      user.Seniority,
+		Timezone:       user.WorkingHours.Timezone,
+		WorkStart:      user.WorkingHours.Start,
+		WorkEnd:        user.WorkingHours.End,
+		Email:          user.Email,
+	}
+}
+
+func fromEntityTags(tags []string) []string {
+	if tags == nil {
+		return []string{}
 	}
+	return tags
+}
+
+func FromEntityUsers(users []entity.User) []SetActiveDTO {
+	res := make([]SetActiveDTO, len(users))
+	for i := range users {
+		res[i] = FromEntityUser(&users[i])
+	}
+	return res
 }
 
 func FromEntityPRShort(pr []entity.PullRequestShort) []PullRequestShortDTO {
@@ -67,3 +242,7 @@ func FromEntityPRShort(pr []entity.PullRequestShort) []PullRequestShortDTO {
 	}
 	return res
 }
+
+func FromEntityReviewerStats(stats *entity.ReviewerStats) ReviewerStatsDTO {
+	return ReviewerStatsDTO(*stats)
+}
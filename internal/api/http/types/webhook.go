@@ -0,0 +1,155 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"pullrequest-service/internal/entity"
+	"strconv"
+	"time"
+)
+
+type WebhookRequestDTO struct {
+	URL        string             `json:"url"`
+	Secret     string             `json:"secret"`
+	EventTypes []entity.EventType `json:"event_types"`
+}
+
+type WebhookDTO struct {
+	ID         int64              `json:"id"`
+	URL        string             `json:"url"`
+	Secret     string             `json:"secret,omitempty"`
+	EventTypes []entity.EventType `json:"event_types"`
+	CreatedAt  time.Time          `json:"created_at"`
+}
+
+type WebhookResponseDTO struct {
+	Webhook WebhookDTO `json:"webhook"`
+}
+
+type WebhookListResponseDTO struct {
+	Webhooks []WebhookDTO `json:"webhooks"`
+}
+
+type DeleteWebhookRequestDTO struct {
+	ID int64 `json:"id"`
+}
+
+type WebhookAttemptDTO struct {
+	Attempt     int       `json:"attempt"`
+	StatusCode  *int      `json:"status_code,omitempty"`
+	Error       string    `json:"error,omitempty"`
+	DurationMs  int64     `json:"duration_ms"`
+	AttemptedAt time.Time `json:"attempted_at"`
+}
+
+type WebhookDeliveryDTO struct {
+	ID            int64                 `json:"id"`
+	EventID       string                `json:"event_id"`
+	EventType     entity.EventType      `json:"event_type"`
+	Status        entity.DeliveryStatus `json:"status"`
+	Attempts      int                   `json:"attempts"`
+	NextAttemptAt *time.Time            `json:"next_attempt_at,omitempty"`
+	LastError     string                `json:"last_error,omitempty"`
+	DeliveredAt   *time.Time            `json:"delivered_at,omitempty"`
+	CreatedAt     time.Time             `json:"created_at"`
+	History       []WebhookAttemptDTO   `json:"history"`
+}
+
+type WebhookDeliveriesResponseDTO struct {
+	WebhookID  int64                `json:"webhook_id"`
+	Deliveries []WebhookDeliveryDTO `json:"deliveries"`
+}
+
+func ParseWebhookRequest(r *http.Request) (*WebhookRequestDTO, error) {
+	var req WebhookRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+
+	return &req, nil
+}
+
+func ParseDeleteWebhookRequest(r *http.Request) (*DeleteWebhookRequestDTO, error) {
+	var req DeleteWebhookRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+
+	return &req, nil
+}
+
+func ParseDeliveryFilter(r *http.Request) (*entity.DeliveryFilter, error) {
+	query := r.URL.Query()
+	filter := &entity.DeliveryFilter{Status: entity.DeliveryStatus(query.Get("status"))}
+
+	webhookId, err := strconv.ParseInt(query.Get("webhook_id"), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid webhook_id", entity.ErrInvalidRequest)
+	}
+	filter.WebhookID = webhookId
+
+	if raw := query.Get("limit"); raw != "" {
+		limit, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid limit", entity.ErrInvalidRequest)
+		}
+		filter.Limit = limit
+	}
+
+	return filter, nil
+}
+
+func (req *WebhookRequestDTO) ToEntity() *entity.Webhook {
+	return &entity.Webhook{URL: req.URL, Secret: req.Secret, EventTypes: req.EventTypes}
+}
+
+func FromEntityWebhook(hook *entity.Webhook, withSecret bool) WebhookDTO {
+	dto := WebhookDTO{ID: hook.ID, URL: hook.URL, EventTypes: hook.EventTypes, CreatedAt: hook.CreatedAt}
+	if withSecret {
+		dto.Secret = hook.Secret
+	}
+	return dto
+}
+
+func FromEntityWebhooks(hooks []entity.Webhook) []WebhookDTO {
+	res := make([]WebhookDTO, len(hooks))
+	for i := range hooks {
+		res[i] = FromEntityWebhook(&hooks[i], false)
+	}
+	return res
+}
+
+func FromEntityDeliveries(deliveries []entity.WebhookDelivery) []WebhookDeliveryDTO {
+	res := make([]WebhookDeliveryDTO, len(deliveries))
+	for i, d := range deliveries {
+		res[i] = WebhookDeliveryDTO{
+			ID:          d.ID,
+			EventID:     d.Event.ID,
+			EventType:   d.Event.Type,
+			Status:      d.Status,
+			Attempts:    d.Attempts,
+			LastError:   d.LastError,
+			DeliveredAt: d.DeliveredAt,
+			CreatedAt:   d.CreatedAt,
+			History:     make([]WebhookAttemptDTO, len(d.History)),
+		}
+
+		if d.Status == entity.DeliveryPending {
+			res[i].NextAttemptAt = &deliveries[i].NextAttemptAt
+		}
+
+		for j, a := range d.History {
+			res[i].History[j] = WebhookAttemptDTO{
+				Attempt:     a.Attempt,
+				StatusCode:  a.StatusCode,
+				Error:       a.Error,
+				DurationMs:  a.Duration.Milliseconds(),
+				AttemptedAt: a.AttemptedAt,
+			}
+		}
+	}
+	return res
+}
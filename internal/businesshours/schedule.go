@@ -0,0 +1,97 @@
+package businesshours
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const clockLayout = "15:04"
+
+var ErrInvalidSchedule = errors.New("invalid working hours")
+
+type Schedule struct {
+	loc   *time.Location
+	start int
+	end   int
+}
+
+func New(timezone, start, end string) (*Schedule, error) {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unknown timezone %q", ErrInvalidSchedule, timezone)
+	}
+
+	startMin, err := parseClock(start)
+	if err != nil {
+		return nil, err
+	}
+
+	endMin, err := parseClock(end)
+	if err != nil {
+		return nil, err
+	}
+
+	if endMin <= startMin {
+		return nil, fmt.Errorf("%w: work_end must be after work_start", ErrInvalidSchedule)
+	}
+
+	return &Schedule{loc: loc, start: startMin, end: endMin}, nil
+}
+
+func parseClock(value string) (int, error) {
+	t, err := time.Parse(clockLayout, value)
+	if err != nil {
+		return 0, fmt.Errorf("%w: time %q must be in HH:MM format", ErrInvalidSchedule, value)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func (s *Schedule) Location() *time.Location {
+	return s.loc
+}
+
+func (s *Schedule) IsWorking(t time.Time) bool {
+	from, to, ok := s.window(t.In(s.loc))
+	return ok && !t.Before(from) && t.Before(to)
+}
+
+func (s *Schedule) Elapsed(from, to time.Time) time.Duration {
+	if !to.After(from) {
+		return 0
+	}
+
+	var elapsed time.Duration
+
+	local := from.In(s.loc)
+	for day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, s.loc); day.Before(to); day = day.AddDate(0, 0, 1) {
+		start, end, ok := s.window(day)
+		if !ok {
+			continue
+		}
+
+		if start.Before(from) {
+			start = from
+		}
+		if end.After(to) {
+			end = to
+		}
+		if end.After(start) {
+			elapsed += end.Sub(start)
+		}
+	}
+
+	return elapsed
+}
+
+func (s *Schedule) window(day time.Time) (time.Time, time.Time, bool) {
+	if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+		return time.Time{}, time.Time{}, false
+	}
+
+	y, m, d := day.Date()
+	start := time.Date(y, m, d, s.start/60, s.start%60, 0, 0, s.loc)
+	end := time.Date(y, m, d, s.end/60, s.end%60, 0, 0, s.loc)
+
+	return start, end, true
+}
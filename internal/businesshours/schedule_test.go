@@ -0,0 +1,120 @@
+package businesshours
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func mustSchedule(t *testing.T, timezone string) *Schedule {
+	t.Helper()
+
+	schedule, err := New(timezone, "09:00", "18:00")
+	if err != nil {
+		t.Fatalf("New(%q): %v", timezone, err)
+	}
+	return schedule
+}
+
+func utc(value string) time.Time {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name     string
+		timezone string
+		start    string
+		end      string
+		wantErr  bool
+	}{
+		{name: "utc", timezone: "UTC", start: "09:00", end: "18:00"},
+		{name: "named zone", timezone: "Asia/Almaty", start: "10:30", end: "19:15"},
+		{name: "unknown zone", timezone: "Mars/Olympus", start: "09:00", end: "18:00", wantErr: true},
+		{name: "bad start", timezone: "UTC", start: "nine", end: "18:00", wantErr: true},
+		{name: "bad end", timezone: "UTC", start: "09:00", end: "25:00", wantErr: true},
+		{name: "empty window", timezone: "UTC", start: "09:00", end: "09:00", wantErr: true},
+		{name: "overnight window", timezone: "UTC", start: "22:00", end: "06:00", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(tt.timezone, tt.start, tt.end)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidSchedule) {
+					t.Fatalf("error = %v, want ErrInvalidSchedule", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestIsWorking(t *testing.T) {
+	tests := []struct {
+		name     string
+		timezone string
+		at       string
+		want     bool
+	}{
+		{name: "start of day", timezone: "UTC", at: "2025-01-06T09:00:00Z", want: true},
+		{name: "before start", timezone: "UTC", at: "2025-01-06T08:59:00Z", want: false},
+		{name: "end is exclusive", timezone: "UTC", at: "2025-01-06T18:00:00Z", want: false},
+		{name: "saturday", timezone: "UTC", at: "2025-01-11T12:00:00Z", want: false},
+		{name: "sunday", timezone: "UTC", at: "2025-01-12T12:00:00Z", want: false},
+		{name: "moscow morning", timezone: "Europe/Moscow", at: "2025-01-06T06:00:00Z", want: true},
+		{name: "moscow evening", timezone: "Europe/Moscow", at: "2025-01-06T15:00:00Z", want: false},
+		{name: "almaty monday in utc sunday", timezone: "Asia/Almaty", at: "2025-01-12T23:00:00Z", want: false},
+		{name: "almaty monday morning", timezone: "Asia/Almaty", at: "2025-01-13T04:00:00Z", want: true},
+		{name: "almaty friday evening", timezone: "Asia/Almaty", at: "2025-01-10T12:30:00Z", want: true},
+		{name: "almaty saturday in utc friday", timezone: "Asia/Almaty", at: "2025-01-10T20:00:00Z", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mustSchedule(t, tt.timezone).IsWorking(utc(tt.at)); got != tt.want {
+				t.Fatalf("IsWorking(%s) = %v, want %v", tt.at, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestElapsed(t *testing.T) {
+	tests := []struct {
+		name     string
+		timezone string
+		from     string
+		to       string
+		want     time.Duration
+	}{
+		{name: "within a day", timezone: "UTC", from: "2025-01-06T10:00:00Z", to: "2025-01-06T12:30:00Z", want: 150 * time.Minute},
+		{name: "starts before hours", timezone: "UTC", from: "2025-01-06T07:00:00Z", to: "2025-01-06T10:00:00Z", want: time.Hour},
+		{name: "outside hours", timezone: "UTC", from: "2025-01-06T19:00:00Z", to: "2025-01-07T08:00:00Z", want: 0},
+		{name: "across midnight", timezone: "UTC", from: "2025-01-06T17:00:00Z", to: "2025-01-07T10:00:00Z", want: 2 * time.Hour},
+		{name: "several days", timezone: "UTC", from: "2025-01-06T09:00:00Z", to: "2025-01-08T18:00:00Z", want: 27 * time.Hour},
+		{name: "over a weekend", timezone: "UTC", from: "2025-01-10T17:00:00Z", to: "2025-01-13T10:00:00Z", want: 2 * time.Hour},
+		{name: "weekend only", timezone: "UTC", from: "2025-01-11T09:00:00Z", to: "2025-01-12T18:00:00Z", want: 0},
+		{name: "full week", timezone: "UTC", from: "2025-01-06T00:00:00Z", to: "2025-01-13T00:00:00Z", want: 45 * time.Hour},
+		{name: "reversed range", timezone: "UTC", from: "2025-01-06T12:00:00Z", to: "2025-01-06T10:00:00Z", want: 0},
+		{name: "moscow day", timezone: "Europe/Moscow", from: "2025-01-06T00:00:00Z", to: "2025-01-06T23:00:00Z", want: 9 * time.Hour},
+		{name: "moscow across midnight", timezone: "Europe/Moscow", from: "2025-01-06T14:00:00Z", to: "2025-01-07T07:00:00Z", want: 2 * time.Hour},
+		{name: "almaty over a weekend", timezone: "Asia/Almaty", from: "2025-01-10T12:00:00Z", to: "2025-01-13T05:00:00Z", want: 2 * time.Hour},
+		{name: "almaty monday starts on utc sunday", timezone: "Asia/Almaty", from: "2025-01-12T20:00:00Z", to: "2025-01-13T05:00:00Z", want: time.Hour},
+		{name: "almaty day ends before utc day", timezone: "Asia/Almaty", from: "2025-01-06T12:00:00Z", to: "2025-01-06T20:00:00Z", want: time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mustSchedule(t, tt.timezone).Elapsed(utc(tt.from), utc(tt.to)); got != tt.want {
+				t.Fatalf("Elapsed(%s, %s) = %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
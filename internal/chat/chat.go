@@ -0,0 +1,65 @@
+package chat
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"pullrequest-service/internal/entity"
+	"time"
+)
+
+const maxResponseBytes = 64 << 10
+
+type message struct {
+	Text    string `json:"text"`
+	Channel string `json:"channel,omitempty"`
+}
+
+type Sender struct {
+	kind entity.ChatKind
+	url  string
+	http *http.Client
+}
+
+func NewSlackSender(webhookURL string, timeout time.Duration) *Sender {
+	return &Sender{kind: entity.ChatSlack, url: webhookURL, http: &http.Client{Timeout: timeout}}
+}
+
+func NewMattermostSender(webhookURL string, timeout time.Duration) *Sender {
+	return &Sender{kind: entity.ChatMattermost, url: webhookURL, http: &http.Client{Timeout: timeout}}
+}
+
+func (s *Sender) Kind() entity.ChatKind {
+	return s.kind
+}
+
+func (s *Sender) Send(ctx context.Context, channel, text string) error {
+	body, err := json.Marshal(message{Text: text, Channel: channel})
+	if err != nil {
+		return fmt.Errorf("encode message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("send %s message: %w", s.kind, err)
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBytes))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("send %s message: unexpected status %d", s.kind, resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package chat
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"pullrequest-service/internal/entity"
+	"testing"
+	"time"
+)
+
+func TestSenderSend(t *testing.T) {
+	tests := []struct {
+		name    string
+		new     func(url string, timeout time.Duration) *Sender
+		kind    entity.ChatKind
+		channel string
+		want    string
+	}{
+		{name: "slack to a channel", new: NewSlackSender, kind: entity.ChatSlack, channel: "@alice", want: `{"text":"hello","channel":"@alice"}`},
+		{name: "slack to the default channel", new: NewSlackSender, kind: entity.ChatSlack, want: `{"text":"hello"}`},
+		{name: "mattermost to a channel", new: NewMattermostSender, kind: entity.ChatMattermost, channel: "town-square",
+			want: `{"text":"hello","channel":"town-square"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got json.RawMessage
+			var contentType string
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				contentType = r.Header.Get("Content-Type")
+				_ = json.NewDecoder(r.Body).Decode(&got)
+				w.Write([]byte("ok"))
+			}))
+			defer server.Close()
+
+			sender := tt.new(server.URL, time.Second)
+
+			if sender.Kind() != tt.kind {
+				t.Errorf("Kind() = %s, want %s", sender.Kind(), tt.kind)
+			}
+
+			if err := sender.Send(context.Background(), tt.channel, "hello"); err != nil {
+				t.Fatalf("Send() error = %v", err)
+			}
+
+			if string(got) != tt.want {
+				t.Errorf("body = %s, want %s", got, tt.want)
+			}
+
+			if contentType != "application/json" {
+				t.Errorf("Content-Type = %q", contentType)
+			}
+		})
+	}
+}
+
+func TestSenderSendStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "invalid_payload", http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	if err := NewSlackSender(server.URL, time.Second).Send(context.Background(), "", "hello"); err == nil {
+		t.Error("Send() error = nil for a 400 response")
+	}
+}
@@ -1,6 +1,10 @@
 package config
 
-import "github.com/ilyakaznacheev/cleanenv"
+import (
+	"time"
+
+	"github.com/ilyakaznacheev/cleanenv"
+)
 
 type Config struct {
 	Server struct {
@@ -14,6 +18,67 @@ type Config struct {
 		User     string `env:"DB_USER" env-default:"postgres"`
 		Password string `env:"DB_PASSWORD" env-default:"password"`
 	} `yaml:"database"`
+
+	Availability struct {
+		ReassignInterval time.Duration `env:"AVAILABILITY_REASSIGN_INTERVAL" env-default:"1m"`
+	} `yaml:"availability"`
+
+	SLA struct {
+		CheckInterval time.Duration `env:"SLA_CHECK_INTERVAL" env-default:"5m"`
+	} `yaml:"sla"`
+
+	Webhooks struct {
+		DeliveryInterval time.Duration `env:"WEBHOOK_DELIVERY_INTERVAL" env-default:"5s"`
+		Timeout          time.Duration `env:"WEBHOOK_TIMEOUT" env-default:"10s"`
+		MaxAttempts      int           `env:"WEBHOOK_MAX_ATTEMPTS" env-default:"8"`
+		BackoffBase      time.Duration `env:"WEBHOOK_BACKOFF_BASE" env-default:"10s"`
+		BackoffMax       time.Duration `env:"WEBHOOK_BACKOFF_MAX" env-default:"1h"`
+	} `yaml:"webhooks"`
+
+	Outbox struct {
+		RelayInterval time.Duration `env:"OUTBOX_RELAY_INTERVAL" env-default:"1s"`
+		Sinks         []string      `env:"OUTBOX_SINKS" env-separator:"," env-default:"webhook,reviewers,notifications"`
+		FilePath      string        `env:"OUTBOX_FILE_PATH" env-default:"events.ndjson"`
+		MaxAttempts   int           `env:"OUTBOX_MAX_ATTEMPTS" env-default:"12"`
+		BackoffBase   time.Duration `env:"OUTBOX_BACKOFF_BASE" env-default:"5s"`
+		BackoffMax    time.Duration `env:"OUTBOX_BACKOFF_MAX" env-default:"10m"`
+	} `yaml:"outbox"`
+
+	EventFeed struct {
+		PollInterval time.Duration `env:"EVENT_FEED_POLL_INTERVAL" env-default:"1s"`
+	} `yaml:"event_feed"`
+
+	Integrations struct {
+		GitHubWebhookSecret string        `env:"GITHUB_WEBHOOK_SECRET"`
+		GitLabWebhookToken  string        `env:"GITLAB_WEBHOOK_TOKEN"`
+		GitHubAPIURL        string        `env:"GITHUB_API_URL" env-default:"https://api.github.com"`
+		GitHubToken         string        `env:"GITHUB_TOKEN"`
+		GitLabURL           string        `env:"GITLAB_URL"`
+		GitLabToken         string        `env:"GITLAB_TOKEN"`
+		Timeout             time.Duration `env:"CODE_HOST_TIMEOUT" env-default:"10s"`
+	} `yaml:"integrations"`
+
+	Notifications struct {
+		SlackWebhookURL      string        `env:"SLACK_WEBHOOK_URL"`
+		MattermostWebhookURL string        `env:"MATTERMOST_WEBHOOK_URL"`
+		DeliveryInterval     time.Duration `env:"NOTIFICATION_DELIVERY_INTERVAL" env-default:"10s"`
+		DigestInterval       time.Duration `env:"NOTIFICATION_DIGEST_INTERVAL" env-default:"24h"`
+		Timeout              time.Duration `env:"NOTIFICATION_TIMEOUT" env-default:"10s"`
+		MaxAttempts          int           `env:"NOTIFICATION_MAX_ATTEMPTS" env-default:"5"`
+		BackoffBase          time.Duration `env:"NOTIFICATION_BACKOFF_BASE" env-default:"30s"`
+		BackoffMax           time.Duration `env:"NOTIFICATION_BACKOFF_MAX" env-default:"30m"`
+	} `yaml:"notifications"`
+
+	Email struct {
+		DigestInterval time.Duration `env:"EMAIL_DIGEST_INTERVAL" env-default:"1h"`
+		SMTPHost       string        `env:"SMTP_HOST"`
+		SMTPPort       int           `env:"SMTP_PORT" env-default:"587"`
+		SMTPUsername   string        `env:"SMTP_USERNAME"`
+		SMTPPassword   string        `env:"SMTP_PASSWORD"`
+		SMTPStartTLS   bool          `env:"SMTP_STARTTLS" env-default:"true"`
+		SMTPTimeout    time.Duration `env:"SMTP_TIMEOUT" env-default:"30s"`
+		From           string        `env:"EMAIL_FROM" env-default:"pr-service@localhost"`
+	} `yaml:"email"`
 }
 
 func LoadConfig() (*Config, error) {
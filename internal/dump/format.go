@@ -0,0 +1,272 @@
+package dump
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"pullrequest-service/internal/entity"
+	"time"
+)
+
+const Version = 1
+
+type Format string
+
+const (
+	FormatJSON   Format = "json"
+	FormatNDJSON Format = "ndjson"
+)
+
+func ParseFormat(raw string) (Format, error) {
+	switch Format(raw) {
+	case "", FormatJSON:
+		return FormatJSON, nil
+	case FormatNDJSON:
+		return FormatNDJSON, nil
+	}
+	return "", fmt.Errorf("%w: unknown dump format %q", entity.ErrInvalidRequest, raw)
+}
+
+func (f Format) ContentType() string {
+	if f == FormatNDJSON {
+		return "application/x-ndjson"
+	}
+	return "application/json"
+}
+
+type Document struct {
+	Version      int           `json:"version"`
+	Teams        []Team        `json:"teams"`
+	Users        []User        `json:"users"`
+	PullRequests []PullRequest `json:"pull_requests"`
+}
+
+type Team struct {
+	TeamName              string `json:"team_name"`
+	DefaultMaxOpenReviews *int   `json:"default_max_open_reviews,omitempty"`
+}
+
+type User struct {
+	UserID         string           `json:"user_id"`
+	UserName       string           `json:"username"`
+	TeamName       string           `json:"team_name"`
+	IsActive       bool             `json:"is_active"`
+	MaxOpenReviews *int             `json:"max_open_reviews,omitempty"`
+	Tags           []string         `json:"tags,omitempty"`
+	Seniority      entity.Seniority `json:"seniority,omitempty"`
+	Timezone       string           `json:"timezone,omitempty"`
+	WorkStart      string           `json:"work_start,omitempty"`
+	WorkEnd        string           `json:"work_end,omitempty"`
+	Email          string           `json:"email,omitempty"`
+}
+
+type PullRequest struct {
+	PullRequestID     string        `json:"pull_request_id"`
+	PullRequestName   string        `json:"pull_request_name"`
+	AuthorID          string        `json:"author_id"`
+	Status            entity.Status `json:"status"`
+	AssignedReviewers []string      `json:"assigned_reviewers"`
+	CreatedAt         *time.Time    `json:"created_at,omitempty"`
+	MergedAt          *time.Time    `json:"merged_at,omitempty"`
+	Tags              []string      `json:"tags,omitempty"`
+}
+
+type record struct {
+	Kind string          `json:"kind"`
+	Data json.RawMessage `json:"data"`
+}
+
+const (
+	kindHeader      = "header"
+	kindTeam        = "team"
+	kindUser        = "user"
+	kindPullRequest = "pull_request"
+)
+
+type header struct {
+	Version int `json:"version"`
+}
+
+func Encode(w io.Writer, format Format, d *entity.Dump) error {
+	doc := FromEntity(d)
+
+	if format == FormatJSON {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(doc)
+	}
+
+	enc := json.NewEncoder(w)
+
+	write := func(kind string, v any) error {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("marshal %s record: %w", kind, err)
+		}
+		return enc.Encode(record{Kind: kind, Data: data})
+	}
+
+	if err := write(kindHeader, header{Version: doc.Version}); err != nil {
+		return err
+	}
+
+	for _, t := range doc.Teams {
+		if err := write(kindTeam, t); err != nil {
+			return err
+		}
+	}
+
+	for _, u := range doc.Users {
+		if err := write(kindUser, u); err != nil {
+			return err
+		}
+	}
+
+	for _, pr := range doc.PullRequests {
+		if err := write(kindPullRequest, pr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func Decode(r io.Reader, format Format) (*entity.Dump, error) {
+	var doc Document
+
+	if format == FormatJSON {
+		if err := json.NewDecoder(r).Decode(&doc); err != nil {
+			return nil, fmt.Errorf("%w: decode dump: %v", entity.ErrInvalidRequest, err)
+		}
+	} else {
+		if err := decodeNDJSON(r, &doc); err != nil {
+			return nil, err
+		}
+	}
+
+	if doc.Version != Version {
+		return nil, fmt.Errorf("%w: unsupported dump version %d", entity.ErrInvalidRequest, doc.Version)
+	}
+
+	return doc.ToEntity(), nil
+}
+
+func decodeNDJSON(r io.Reader, doc *Document) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var rec record
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			return fmt.Errorf("%w: line %d: %v", entity.ErrInvalidRequest, line, err)
+		}
+
+		if line == 1 && rec.Kind != kindHeader {
+			return fmt.Errorf("%w: line 1: expected header record", entity.ErrInvalidRequest)
+		}
+
+		var err error
+		switch rec.Kind {
+		case kindHeader:
+			var h header
+			err = json.Unmarshal(rec.Data, &h)
+			doc.Version = h.Version
+		case kindTeam:
+			var t Team
+			err = json.Unmarshal(rec.Data, &t)
+			doc.Teams = append(doc.Teams, t)
+		case kindUser:
+			var u User
+			err = json.Unmarshal(rec.Data, &u)
+			doc.Users = append(doc.Users, u)
+		case kindPullRequest:
+			var pr PullRequest
+			err = json.Unmarshal(rec.Data, &pr)
+			doc.PullRequests = append(doc.PullRequests, pr)
+		default:
+			err = fmt.Errorf("unknown record kind %q", rec.Kind)
+		}
+
+		if err != nil {
+			return fmt.Errorf("%w: line %d: %v", entity.ErrInvalidRequest, line, err)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("%w: read dump: %v", entity.ErrInvalidRequest, err)
+	}
+
+	return nil
+}
+
+func FromEntity(d *entity.Dump) *Document {
+	doc := &Document{
+		Version:      Version,
+		Teams:        make([]Team, len(d.Teams)),
+		Users:        make([]User, len(d.Users)),
+		PullRequests: make([]PullRequest, len(d.PullRequests)),
+	}
+
+	for i, t := range d.Teams {
+		doc.Teams[i] = Team{TeamName: t.TeamName, DefaultMaxOpenReviews: t.DefaultMaxOpenReviews}
+	}
+
+	for i, u := range d.Users {
+		doc.Users[i] = User{UserID: u.UserID, UserName: u.UserName, TeamName: u.TeamName, IsActive: u.IsActive, MaxOpenReviews: u.MaxOpenReviews, Tags: u.Tags,
+			Seniority: u.Seniority, Timezone: u.WorkingHours.Timezone, WorkStart: u.WorkingHours.Start, WorkEnd: u.WorkingHours.End, Email: u.Email}
+	}
+
+	for i, pr := range d.PullRequests {
+		doc.PullRequests[i] = PullRequest{
+			PullRequestID:     pr.PullRequestID,
+			PullRequestName:   pr.PullRequestName,
+			AuthorID:          pr.AuthorID,
+			Status:            pr.Status,
+			AssignedReviewers: pr.AssignedReviewers,
+			CreatedAt:         pr.CreatedAt,
+			MergedAt:          pr.MergedAt,
+			Tags:              pr.Tags,
+		}
+	}
+
+	return doc
+}
+
+func (doc *Document) ToEntity() *entity.Dump {
+	d := &entity.Dump{
+		Teams:        make([]entity.Team, len(doc.Teams)),
+		Users:        make([]entity.User, len(doc.Users)),
+		PullRequests: make([]entity.PullRequest, len(doc.PullRequests)),
+	}
+
+	for i, t := range doc.Teams {
+		d.Teams[i] = entity.Team{TeamName: t.TeamName, DefaultMaxOpenReviews: t.DefaultMaxOpenReviews}
+	}
+
+	for i, u := range doc.Users {
+		d.Users[i] = entity.User{UserID: u.UserID, UserName: u.UserName, TeamName: u.TeamName, IsActive: u.IsActive, MaxOpenReviews: u.MaxOpenReviews, Tags: u.Tags,
+			Seniority: u.Seniority, WorkingHours: entity.WorkingHours{Timezone: u.Timezone, Start: u.WorkStart, End: u.WorkEnd}, Email: u.Email}
+	}
+
+	for i, pr := range doc.PullRequests {
+		d.PullRequests[i] = entity.PullRequest{
+			PullRequestID:     pr.PullRequestID,
+			PullRequestName:   pr.PullRequestName,
+			AuthorID:          pr.AuthorID,
+			Status:            pr.Status,
+			AssignedReviewers: pr.AssignedReviewers,
+			CreatedAt:         pr.CreatedAt,
+			MergedAt:          pr.MergedAt,
+			Tags:              pr.Tags,
+		}
+	}
+
+	return d
+}
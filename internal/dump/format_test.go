@@ -0,0 +1,38 @@
+package dump
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestEncodeDecodeRoundTrip(t *testing.T) {
+	maxOpenReviews := 3
+
+	doc := Document{
+		Version: Version,
+		Teams:   []Team{{TeamName: "backend", DefaultMaxOpenReviews: &maxOpenReviews}},
+		Users: []User{{UserID: "u1", UserName: "Alice", TeamName: "backend", IsActive: true, Tags: []string{"go"}, Seniority: "senior",
+			Timezone: "Europe/Moscow", WorkStart: "10:00", WorkEnd: "19:00", Email: "alice@example.com"}},
+		PullRequests: []PullRequest{{PullRequestID: "pr-1", PullRequestName: "Add search", AuthorID: "u1", Status: "OPEN",
+			AssignedReviewers: []string{}}},
+	}
+
+	for _, format := range []Format{FormatJSON, FormatNDJSON} {
+		t.Run(string(format), func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := Encode(&buf, format, doc.ToEntity()); err != nil {
+				t.Fatalf("Encode() error = %v", err)
+			}
+
+			decoded, err := Decode(&buf, format)
+			if err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+
+			if got := FromEntity(decoded); !reflect.DeepEqual(*got, doc) {
+				t.Errorf("round trip = %+v, want %+v", got, doc)
+			}
+		})
+	}
+}
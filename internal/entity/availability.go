@@ -0,0 +1,32 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+type Unavailability struct {
+	ID                  int64
+	UserID              string
+	StartsAt            time.Time
+	EndsAt              time.Time
+	Reason              string
+	ReassignReviews     bool
+	ReviewsReassignedAt *time.Time
+}
+
+func (u *Unavailability) Validate() error {
+	if u.UserID == "" {
+		return fmt.Errorf("%w: empty user_id", ErrInvalidRequest)
+	}
+
+	if u.StartsAt.IsZero() || u.EndsAt.IsZero() {
+		return fmt.Errorf("%w: empty unavailability window", ErrInvalidRequest)
+	}
+
+	if !u.EndsAt.After(u.StartsAt) {
+		return fmt.Errorf("%w: ends_at must be after starts_at", ErrInvalidRequest)
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package entity
+
+import "fmt"
+
+type CodeOwnerRule struct {
+	ID         int64
+	TeamName   string
+	Pattern    string
+	OwnerUsers []string
+	OwnerTeams []string
+}
+
+func (r *CodeOwnerRule) Validate() error {
+	if r.TeamName == "" {
+		return fmt.Errorf("%w: empty team_name", ErrInvalidRequest)
+	}
+
+	if r.Pattern == "" {
+		return fmt.Errorf("%w: empty pattern", ErrInvalidRequest)
+	}
+
+	if len(r.OwnerUsers) == 0 && len(r.OwnerTeams) == 0 {
+		return fmt.Errorf("%w: rule must have at least one owner", ErrInvalidRequest)
+	}
+
+	for _, owner := range append(r.OwnerUsers, r.OwnerTeams...) {
+		if owner == "" {
+			return fmt.Errorf("%w: empty owner", ErrInvalidRequest)
+		}
+	}
+
+	return nil
+}
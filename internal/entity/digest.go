@@ -0,0 +1,28 @@
+package entity
+
+import "time"
+
+type SLAStatus string
+
+const (
+	SLAStatusNone    SLAStatus = "none"
+	SLAStatusOnTrack SLAStatus = "on_track"
+	SLAStatusOverdue SLAStatus = "overdue"
+)
+
+type DigestReview struct {
+	PullRequestID   string
+	PullRequestName string
+	AuthorID        string
+	AssignedAt      time.Time
+	Age             time.Duration
+	WorkingAge      time.Duration
+	SLAStatus       SLAStatus
+	SLADueIn        time.Duration
+}
+
+type ReviewDigest struct {
+	User        User
+	Reviews     []DigestReview
+	GeneratedAt time.Time
+}
@@ -0,0 +1,37 @@
+package entity
+
+import "fmt"
+
+type ConflictPolicy string
+
+const (
+	ConflictSkip      ConflictPolicy = "skip"
+	ConflictOverwrite ConflictPolicy = "overwrite"
+	ConflictFail      ConflictPolicy = "fail"
+)
+
+func (p ConflictPolicy) Validate() error {
+	switch p {
+	case ConflictSkip, ConflictOverwrite, ConflictFail:
+		return nil
+	}
+	return fmt.Errorf("%w: unknown conflict policy %q", ErrInvalidRequest, p)
+}
+
+type Dump struct {
+	Teams        []Team
+	Users        []User
+	PullRequests []PullRequest
+}
+
+type ImportStats struct {
+	Created int
+	Updated int
+	Skipped int
+}
+
+type ImportResult struct {
+	Teams        ImportStats
+	Users        ImportStats
+	PullRequests ImportStats
+}
@@ -4,10 +4,15 @@ const (
 	CodeTeamExists        = "TEAM_EXISTS"
 	CodePRExists          = "PR_EXISTS"
 	CodePRMerged          = "PR_MERGED"
+	CodePRClosed          = "PR_CLOSED"
 	CodeNotAssigned       = "NOT_ASSIGNED"
+	CodeAssigned          = "ALREADY_ASSIGNED"
 	CodeNoCandidate       = "NO_CANDIDATE"
 	CodeNotFound          = "NOT_FOUND"
 	CodeInvalidReq        = "INVALID_REQUEST"
+	CodeUnauthorized      = "UNAUTHORIZED"
 	CodeInternal          = "INTERNAL_ERROR"
 	CodeUserInAnotherTeam = "USER_EXISTS"
+	CodeUserIsAuthor      = "USER_IS_AUTHOR"
+	CodeImportConflict    = "IMPORT_CONFLICT"
 )
@@ -5,15 +5,23 @@ import "errors"
 var (
 	ErrNotFound       = errors.New("resource not found")
 	ErrInvalidRequest = errors.New("invalid request")
+	ErrUnauthorized   = errors.New("unauthorized")
 
 	ErrTeamExists        = errors.New("team_name already exists")
 	ErrUserInAnotherTeam = errors.New("user already in another team")
+	ErrUserIsAuthor      = errors.New("user is an author of pull requests")
 
 	ErrPRExists    = errors.New("PR is already exists")
 	ErrPRMerged    = errors.New("cannot reassign on merged PR")
+	ErrPRClosed    = errors.New("cannot change closed PR")
 	ErrNotAssigned = errors.New("reviewer is not assigned to this PR")
+	ErrAssigned    = errors.New("reviewer is already assigned to this PR")
 	ErrNoCandidate = errors.New("no active replacement candidate in team")
 
+	ErrImportConflict = errors.New("imported record conflicts with existing data")
+
+	ErrCodeHostRejected = errors.New("code host rejected request")
+
 	ErrSerializationFailure = errors.New("serialization failure")
 	ErrInternalError        = errors.New("internal error")
 )
@@ -0,0 +1,120 @@
+package entity
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+type EventType string
+
+const (
+	EventPRCreated          EventType = "pr.created"
+	EventPRMerged           EventType = "pr.merged"
+	EventPRClosed           EventType = "pr.closed"
+	EventPRReopened         EventType = "pr.reopened"
+	EventReviewerAssigned   EventType = "pr.reviewer_assigned"
+	EventReviewerReassigned EventType = "pr.reviewer_reassigned"
+	EventReviewerRemoved    EventType = "pr.reviewer_removed"
+	EventUserDeactivated    EventType = "user.deactivated"
+)
+
+var EventTypes = []EventType{EventPRCreated, EventPRMerged, EventPRClosed, EventPRReopened, EventReviewerAssigned, EventReviewerReassigned,
+	EventReviewerRemoved, EventUserDeactivated}
+
+func (t EventType) Validate() error {
+	for _, known := range EventTypes {
+		if t == known {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: unknown event type %q", ErrInvalidRequest, t)
+}
+
+type Event struct {
+	ID            string
+	Type          EventType
+	OccurredAt    time.Time
+	PullRequest   *PullRequest
+	ReviewerIDs   []string
+	OldReviewerID string
+	User          *User
+}
+
+type OutboxEvent struct {
+	ID             int64
+	Event          Event
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastError      string
+	DeliveredSinks []string
+	CreatedAt      time.Time
+	ProcessedAt    *time.Time
+	DeadAt         *time.Time
+}
+
+func (e *Event) OrderingKey() string {
+	switch {
+	case e.PullRequest != nil:
+		return "pr:" + e.PullRequest.PullRequestID
+	case e.User != nil:
+		return "user:" + e.User.UserID
+	default:
+		return "event:" + e.ID
+	}
+}
+
+func NewEvent(eventType EventType) Event {
+	return Event{ID: RandomToken(16), Type: eventType, OccurredAt: time.Now().UTC()}
+}
+
+func NewPREvent(eventType EventType, pr *PullRequest) Event {
+	event := NewEvent(eventType)
+	event.PullRequest = &PullRequest{
+		PullRequestID:     pr.PullRequestID,
+		PullRequestName:   pr.PullRequestName,
+		AuthorID:          pr.AuthorID,
+		Status:            pr.Status,
+		AssignedReviewers: pr.AssignedReviewers,
+		CreatedAt:         pr.CreatedAt,
+		MergedAt:          pr.MergedAt,
+		Tags:              pr.Tags,
+	}
+	return event
+}
+
+func RandomToken(size int) string {
+	buf := make([]byte, size)
+	if _, err := rand.Read(buf); err != nil {
+		panic(fmt.Sprintf("crypto/rand: %v", err))
+	}
+	return hex.EncodeToString(buf)
+}
+
+func (e *Event) UserIDs() []string {
+	var userIds []string
+	if pr := e.PullRequest; pr != nil {
+		userIds = append(userIds, pr.AuthorID)
+		userIds = append(userIds, pr.AssignedReviewers...)
+	}
+	userIds = append(userIds, e.ReviewerIDs...)
+	if e.OldReviewerID != "" {
+		userIds = append(userIds, e.OldReviewerID)
+	}
+	if e.User != nil {
+		userIds = append(userIds, e.User.UserID)
+	}
+	return userIds
+}
+
+type EventStreamFilter struct {
+	TeamName string
+	UserID   string
+}
+
+type EventSubscription struct {
+	Backlog []Event
+	Events  <-chan Event
+	Close   func()
+}
@@ -0,0 +1,95 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+type Provider string
+
+const (
+	ProviderGitHub Provider = "github"
+	ProviderGitLab Provider = "gitlab"
+)
+
+var Providers = []Provider{ProviderGitHub, ProviderGitLab}
+
+func (p Provider) Validate() error {
+	for _, known := range Providers {
+		if p == known {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: unknown provider %q", ErrInvalidRequest, p)
+}
+
+type ExternalAccount struct {
+	Provider Provider
+	Login    string
+	UserID   string
+}
+
+func NormalizeLogin(login string) string {
+	return strings.ToLower(strings.TrimSpace(login))
+}
+
+func (a *ExternalAccount) Validate() error {
+	if err := a.Provider.Validate(); err != nil {
+		return err
+	}
+
+	if a.Login == "" || a.UserID == "" {
+		return fmt.Errorf("%w: login and user_id are required", ErrInvalidRequest)
+	}
+
+	return nil
+}
+
+type ExternalPRAction string
+
+const (
+	ExternalPROpened   ExternalPRAction = "opened"
+	ExternalPRClosed   ExternalPRAction = "closed"
+	ExternalPRReopened ExternalPRAction = "reopened"
+	ExternalPRDraft    ExternalPRAction = "draft"
+	ExternalPRReady    ExternalPRAction = "ready"
+)
+
+type ExternalPREvent struct {
+	Provider      Provider
+	Action        ExternalPRAction
+	PullRequestID string
+	Title         string
+	AuthorLogin   string
+	AuthorID      string
+	Merged        bool
+	Draft         bool
+}
+
+type UnmappedEvent struct {
+	ID        int64
+	Login     string
+	Event     ExternalPREvent
+	CreatedAt time.Time
+}
+
+type IngestStatus string
+
+const (
+	IngestApplied IngestStatus = "applied"
+	IngestIgnored IngestStatus = "ignored"
+	IngestQueued  IngestStatus = "queued"
+)
+
+type IngestResult struct {
+	Status      IngestStatus
+	Reason      string
+	PullRequest *PullRequest
+}
+
+type ReviewerUpdate struct {
+	PullRequestID string
+	Reviewers     []string
+	Removed       []string
+}
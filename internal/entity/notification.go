@@ -0,0 +1,90 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+type ChatKind string
+
+const (
+	ChatSlack      ChatKind = "slack"
+	ChatMattermost ChatKind = "mattermost"
+)
+
+var ChatKinds = []ChatKind{ChatSlack, ChatMattermost}
+
+func (k ChatKind) Validate() error {
+	for _, known := range ChatKinds {
+		if k == known {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: unknown chat %q", ErrInvalidRequest, k)
+}
+
+type ChatChannel struct {
+	Kind    ChatKind
+	Channel string
+}
+
+func (c ChatChannel) Key() string {
+	if c.Channel == "" {
+		return string(c.Kind)
+	}
+	return string(c.Kind) + ":" + c.Channel
+}
+
+type NotificationPreferences struct {
+	UserID     string
+	Channels   []ChatChannel
+	Mute       bool
+	DigestOnly bool
+}
+
+func DefaultNotificationPreferences(userId string) *NotificationPreferences {
+	channels := make([]ChatChannel, len(ChatKinds))
+	for i, kind := range ChatKinds {
+		channels[i] = ChatChannel{Kind: kind}
+	}
+	return &NotificationPreferences{UserID: userId, Channels: channels}
+}
+
+func (p *NotificationPreferences) Validate() error {
+	if p.UserID == "" {
+		return fmt.Errorf("%w: empty user_id", ErrInvalidRequest)
+	}
+
+	for _, channel := range p.Channels {
+		if err := channel.Kind.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type NotificationKind string
+
+const (
+	NotificationAssigned   NotificationKind = "assigned"
+	NotificationUnassigned NotificationKind = "unassigned"
+	NotificationMerged     NotificationKind = "merged"
+)
+
+type Notification struct {
+	ID            int64
+	UserID        string
+	EventID       string
+	Kind          NotificationKind
+	PullRequestID string
+	Text          string
+	Digest        bool
+	Status        DeliveryStatus
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	SentChannels  []string
+	SentAt        *time.Time
+	CreatedAt     time.Time
+}
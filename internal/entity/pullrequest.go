@@ -7,6 +7,7 @@ type Status string
 const (
 	MERGED Status = "MERGED"
 	OPEN   Status = "OPEN"
+	CLOSED Status = "CLOSED"
 )
 
 type PullRequest struct {
@@ -17,6 +18,12 @@ type PullRequest struct {
 	AssignedReviewers []string
 	CreatedAt         *time.Time
 	MergedAt          *time.Time
+	Tags              []string
+	Warnings          []string
+	FallbackReviewers []ReviewerAssignment
+	Explanation       *SelectionExplanation
+	OverdueReviewers  []string
+	ReviewAges        map[string]time.Duration
 }
 
 type PullRequestShort struct {
@@ -25,3 +32,17 @@ type PullRequestShort struct {
 	AuthorID        string
 	Status          Status
 }
+
+type CreatePRRequest struct {
+	PullRequestID   string
+	PullRequestName string
+	AuthorID        string
+	ChangedFiles    []string
+	Tags            []string
+}
+
+type SuggestReviewersRequest struct {
+	AuthorID     string
+	ChangedFiles []string
+	Tags         []string
+}
@@ -0,0 +1,107 @@
+package entity
+
+import "time"
+
+type SelectionReason string
+
+const (
+	SelectionCodeOwner  SelectionReason = "code_owner"
+	SelectionTeam       SelectionReason = "team"
+	SelectionFallback   SelectionReason = "fallback_team"
+	SelectionMentorship SelectionReason = "mentorship"
+	SelectionManual     SelectionReason = "manual"
+)
+
+type ExclusionReason string
+
+const (
+	ExclusionAuthor           ExclusionReason = "author"
+	ExclusionAlreadyAssigned  ExclusionReason = "already_assigned"
+	ExclusionInactive         ExclusionReason = "inactive"
+	ExclusionOutOfOffice      ExclusionReason = "out_of_office"
+	ExclusionAtCapacity       ExclusionReason = "at_capacity"
+	ExclusionReplacedByMentor ExclusionReason = "replaced_by_mentor"
+)
+
+type ReviewerAssignment struct {
+	UserID   string
+	TeamName string
+	Fallback bool
+	Reason   SelectionReason
+	Score    int
+	AtWork   bool
+}
+
+type ExcludedCandidate struct {
+	UserID string
+	Reason ExclusionReason
+}
+
+type SelectionExplanation struct {
+	PoolSize  int
+	Excluded  []ExcludedCandidate
+	Reviewers []ReviewerAssignment
+}
+
+type AssignmentAction string
+
+const (
+	AssignmentCreated    AssignmentAction = "create"
+	AssignmentReassigned AssignmentAction = "reassign"
+	AssignmentAdded      AssignmentAction = "add"
+	AssignmentRemoved    AssignmentAction = "remove"
+	AssignmentDeclined   AssignmentAction = "decline"
+	AssignmentOverdue    AssignmentAction = "overdue"
+)
+
+type ReviewerStats struct {
+	UserID       string
+	OpenReviews  int
+	TotalReviews int
+	Declined     int
+}
+
+type AssignmentRecord struct {
+	ID            int64
+	PullRequestID string
+	Action        AssignmentAction
+	ReviewerIDs   []string
+	OldReviewerID string
+	Reason        string
+	Explanation   SelectionExplanation
+	CreatedAt     time.Time
+}
+
+type ReviewerQuery struct {
+	TeamName     string
+	AuthorID     string
+	Count        int
+	Exclude      []string
+	ChangedFiles []string
+	Tags         []string
+	Retained     []string
+}
+
+type ReviewerSelection struct {
+	Reviewers   []ReviewerAssignment
+	Warnings    []string
+	Explanation SelectionExplanation
+}
+
+func ReviewerIDs(assignments []ReviewerAssignment) []string {
+	ids := make([]string, len(assignments))
+	for i, a := range assignments {
+		ids[i] = a.UserID
+	}
+	return ids
+}
+
+func FallbackReviewers(assignments []ReviewerAssignment) []ReviewerAssignment {
+	var fallback []ReviewerAssignment
+	for _, a := range assignments {
+		if a.Fallback {
+			fallback = append(fallback, a)
+		}
+	}
+	return fallback
+}
@@ -0,0 +1,27 @@
+package entity
+
+import "fmt"
+
+type Seniority string
+
+const (
+	SeniorityJunior Seniority = "junior"
+	SeniorityMiddle Seniority = "middle"
+	SenioritySenior Seniority = "senior"
+)
+
+func (s Seniority) Validate() error {
+	switch s {
+	case SeniorityJunior, SeniorityMiddle, SenioritySenior:
+		return nil
+	default:
+		return fmt.Errorf("%w: unknown seniority %q", ErrInvalidRequest, s)
+	}
+}
+
+func (s Seniority) OrDefault() Seniority {
+	if s == "" {
+		return SeniorityMiddle
+	}
+	return s
+}
@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+type SLAAction string
+
+const (
+	SLAActionFlag     SLAAction = "flag"
+	SLAActionReassign SLAAction = "reassign"
+)
+
+func (a SLAAction) Validate() error {
+	switch a {
+	case SLAActionFlag, SLAActionReassign:
+		return nil
+	default:
+		return fmt.Errorf("%w: unknown sla_action %q", ErrInvalidRequest, a)
+	}
+}
+
+func (a SLAAction) OrDefault() SLAAction {
+	if a == "" {
+		return SLAActionFlag
+	}
+	return a
+}
+
+type SLAReview struct {
+	PullRequestID string
+	ReviewerID    string
+	AssignedAt    time.Time
+	SLAHours      int
+	Action        SLAAction
+	WorkingHours  WorkingHours
+}
+
+type ReviewState struct {
+	ReviewerID   string
+	AssignedAt   time.Time
+	RespondedAt  *time.Time
+	OverdueAt    *time.Time
+	WorkingHours WorkingHours
+}
+
+func (s ReviewState) Pending() bool {
+	return s.RespondedAt == nil
+}
+
+func (s ReviewState) Overdue() bool {
+	return s.RespondedAt == nil && s.OverdueAt != nil
+}
@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+func NormalizeTags(tags []string) ([]string, error) {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			return nil, fmt.Errorf("%w: empty tag", ErrInvalidRequest)
+		}
+		normalized = append(normalized, tag)
+	}
+
+	slices.Sort(normalized)
+	return slices.Compact(normalized), nil
+}
+
+func TagsOverlap(a, b []string) int {
+	overlap := 0
+	for _, tag := range a {
+		if slices.Contains(b, tag) {
+			overlap++
+		}
+	}
+	return overlap
+}
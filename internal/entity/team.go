@@ -3,14 +3,64 @@ package entity
 import "fmt"
 
 type Team struct {
-	TeamName string
-	Members  []TeamMember
+	TeamName              string
+	Members               []TeamMember
+	DefaultMaxOpenReviews *int
 }
 
 type TeamMember struct {
-	UserID   string
-	UserName string
-	IsActive bool
+	UserID    string
+	UserName  string
+	IsActive  bool
+	Tags      []string
+	Seniority Seniority
+}
+
+type TeamPolicy struct {
+	MentorshipRequired  bool
+	RepeatPairingWindow int
+	ReviewSLAHours      int
+	SLAAction           SLAAction
+}
+
+const MaxRepeatPairingWindow = 100
+
+func (p *TeamPolicy) Validate() error {
+	if p.RepeatPairingWindow < 0 || p.RepeatPairingWindow > MaxRepeatPairingWindow {
+		return fmt.Errorf("%w: repeat_pairing_window must be between 0 and %d", ErrInvalidRequest, MaxRepeatPairingWindow)
+	}
+
+	if p.ReviewSLAHours < 0 {
+		return fmt.Errorf("%w: review_sla_hours must not be negative", ErrInvalidRequest)
+	}
+
+	return p.SLAAction.OrDefault().Validate()
+}
+
+func ValidateMaxOpenReviews(maxOpenReviews *int) error {
+	if maxOpenReviews != nil && *maxOpenReviews <= 0 {
+		return fmt.Errorf("%w: max_open_reviews must be positive", ErrInvalidRequest)
+	}
+	return nil
+}
+
+func ValidateFallbackTeams(teamName string, fallbackTeams []string) error {
+	seen := make(map[string]struct{}, len(fallbackTeams))
+	for _, fallbackTeam := range fallbackTeams {
+		if fallbackTeam == "" {
+			return fmt.Errorf("%w: empty fallback team_name", ErrInvalidRequest)
+		}
+
+		if fallbackTeam == teamName {
+			return fmt.Errorf("%w: team cannot fall back to itself", ErrInvalidRequest)
+		}
+
+		if _, ok := seen[fallbackTeam]; ok {
+			return fmt.Errorf("%w: duplicate fallback team %s", ErrInvalidRequest, fallbackTeam)
+		}
+		seen[fallbackTeam] = struct{}{}
+	}
+	return nil
 }
 
 func (t *Team) Validate() error {
@@ -18,10 +68,46 @@ func (t *Team) Validate() error {
 		return fmt.Errorf("%w: empty team_name", ErrInvalidRequest)
 	}
 
-	for _, m := range t.Members {
+	seen := make(map[string]struct{}, len(t.Members))
+	for i, m := range t.Members {
 		if m.UserID == "" || m.UserName == "" {
 			return fmt.Errorf("%w: empty user data", ErrInvalidRequest)
 		}
+
+		if m.Seniority != "" {
+			if err := m.Seniority.Validate(); err != nil {
+				return err
+			}
+		}
+
+		if m.Tags != nil {
+			tags, err := NormalizeTags(m.Tags)
+			if err != nil {
+				return err
+			}
+			t.Members[i].Tags = tags
+		}
+
+		if _, ok := seen[m.UserID]; ok {
+			return fmt.Errorf("%w: duplicate user_id %s", ErrInvalidRequest, m.UserID)
+		}
+		seen[m.UserID] = struct{}{}
 	}
 	return nil
 }
+
+type TeamSyncPlan struct {
+	TeamName    string
+	TeamCreated bool
+	Created     []TeamMember
+	Updated     []TeamMember
+	Removed     []string
+	Reassigned  []ReviewerChange
+	DryRun      bool
+}
+
+type ReviewerChange struct {
+	PullRequestID string
+	OldReviewerID string
+	NewReviewerID string
+}
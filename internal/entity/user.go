@@ -1,8 +1,20 @@
 package entity
 
 type User struct {
-	UserID   string
-	UserName string
-	TeamName string
-	IsActive bool
+	UserID         string
+	UserName       string
+	TeamName       string
+	IsActive       bool
+	MaxOpenReviews *int
+	Tags           []string
+	Seniority      Seniority
+	WorkingHours   WorkingHours
+	Email          string
+}
+
+type UserFilter struct {
+	TeamName *string
+	IsActive *bool
+	Limit    uint64
+	Offset   uint64
 }
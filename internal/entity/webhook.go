@@ -0,0 +1,88 @@
+package entity
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+)
+
+type Webhook struct {
+	ID         int64
+	URL        string
+	Secret     string
+	EventTypes []EventType
+	CreatedAt  time.Time
+}
+
+func (w *Webhook) Validate() error {
+	parsed, err := url.Parse(w.URL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("%w: url must be an absolute http(s) URL", ErrInvalidRequest)
+	}
+
+	if len(w.EventTypes) == 0 {
+		return fmt.Errorf("%w: empty event_types", ErrInvalidRequest)
+	}
+
+	for _, eventType := range w.EventTypes {
+		if err := eventType.Validate(); err != nil {
+			return err
+		}
+	}
+
+	slices.Sort(w.EventTypes)
+	w.EventTypes = slices.Compact(w.EventTypes)
+
+	return nil
+}
+
+type DeliveryStatus string
+
+const (
+	DeliveryPending   DeliveryStatus = "pending"
+	DeliveryDelivered DeliveryStatus = "delivered"
+	DeliveryFailed    DeliveryStatus = "failed"
+)
+
+type WebhookDelivery struct {
+	ID            int64
+	WebhookID     int64
+	Event         Event
+	Status        DeliveryStatus
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	DeliveredAt   *time.Time
+	CreatedAt     time.Time
+	History       []WebhookAttempt
+}
+
+type WebhookAttempt struct {
+	DeliveryID  int64
+	Attempt     int
+	StatusCode  *int
+	Error       string
+	Duration    time.Duration
+	AttemptedAt time.Time
+}
+
+type DeliveryFilter struct {
+	WebhookID int64
+	Status    DeliveryStatus
+	Limit     uint64
+}
+
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+func (p RetryPolicy) Delay(attempt int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < attempt && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, p.MaxDelay)
+}
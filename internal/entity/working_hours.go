@@ -0,0 +1,99 @@
+package entity
+
+import (
+	"fmt"
+	"pullrequest-service/internal/businesshours"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultTimezone  = "UTC"
+	DefaultWorkStart = "09:00"
+	DefaultWorkEnd   = "18:00"
+)
+
+type WorkingHours struct {
+	Timezone string
+	Start    string
+	End      string
+}
+
+func (w WorkingHours) OrDefault() WorkingHours {
+	if w.Timezone == "" {
+		w.Timezone = DefaultTimezone
+	}
+	if w.Start == "" {
+		w.Start = DefaultWorkStart
+	}
+	if w.End == "" {
+		w.End = DefaultWorkEnd
+	}
+	return w
+}
+
+const maxCachedSchedules = 1024
+
+// schedules caches parsed schedules: ranking candidates and computing review
+// ages would otherwise load the timezone for every user on every call.
+var schedules = struct {
+	sync.Mutex
+	byHours map[WorkingHours]*businesshours.Schedule
+}{byHours: make(map[WorkingHours]*businesshours.Schedule)}
+
+func (w WorkingHours) Schedule() (*businesshours.Schedule, error) {
+	w = w.OrDefault()
+
+	schedules.Lock()
+	schedule, ok := schedules.byHours[w]
+	schedules.Unlock()
+
+	if ok {
+		return schedule, nil
+	}
+
+	schedule, err := businesshours.New(w.Timezone, w.Start, w.End)
+	if err != nil {
+		return nil, err
+	}
+
+	schedules.Lock()
+	if len(schedules.byHours) >= maxCachedSchedules {
+		clear(schedules.byHours)
+	}
+	schedules.byHours[w] = schedule
+	schedules.Unlock()
+
+	return schedule, nil
+}
+
+func (w WorkingHours) Validate() error {
+	if _, err := w.Schedule(); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+	}
+	return nil
+}
+
+func (w WorkingHours) Elapsed(from, to time.Time) time.Duration {
+	schedule, err := w.Schedule()
+	if err != nil {
+		return to.Sub(from)
+	}
+	return schedule.Elapsed(from, to)
+}
+
+func (w WorkingHours) IsWorking(t time.Time) bool {
+	schedule, err := w.Schedule()
+	if err != nil {
+		return true
+	}
+	return schedule.IsWorking(t)
+}
+
+func (w WorkingHours) LocalDate(t time.Time) string {
+	schedule, err := w.Schedule()
+	if err != nil {
+		return t.UTC().Format(time.DateOnly)
+	}
+	return t.In(schedule.Location()).Format(time.DateOnly)
+}
@@ -0,0 +1,66 @@
+package events
+
+import (
+	"pullrequest-service/internal/entity"
+	"sync"
+)
+
+type Hub struct {
+	mu     sync.Mutex
+	subs   map[chan entity.Event]struct{}
+	closed bool
+}
+
+func NewHub() *Hub {
+	return &Hub{subs: make(map[chan entity.Event]struct{})}
+}
+
+func (h *Hub) Broadcast(event entity.Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ch := range h.subs {
+		select {
+		case ch <- event:
+		default:
+			delete(h.subs, ch)
+			close(ch)
+		}
+	}
+}
+
+func (h *Hub) Subscribe(buffer int) (<-chan entity.Event, func()) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	ch := make(chan entity.Event, buffer)
+	if h.closed {
+		close(ch)
+		return ch, func() {}
+	}
+
+	h.subs[ch] = struct{}{}
+
+	unsubscribe := func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+
+		if _, ok := h.subs[ch]; ok {
+			delete(h.subs, ch)
+			close(ch)
+		}
+	}
+
+	return ch, unsubscribe
+}
+
+func (h *Hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+	for ch := range h.subs {
+		delete(h.subs, ch)
+		close(ch)
+	}
+}
@@ -0,0 +1,65 @@
+package events
+
+import (
+	"encoding/json"
+	"pullrequest-service/internal/entity"
+	"time"
+)
+
+type Payload struct {
+	ID         string           `json:"id"`
+	Type       entity.EventType `json:"type"`
+	OccurredAt time.Time        `json:"occurred_at"`
+	Data       PayloadData      `json:"data"`
+}
+
+type PayloadData struct {
+	PullRequest   *PullRequest `json:"pull_request,omitempty"`
+	ReviewerIDs   []string     `json:"reviewer_ids,omitempty"`
+	OldReviewerID string       `json:"old_reviewer_id,omitempty"`
+	User          *User        `json:"user,omitempty"`
+}
+
+type PullRequest struct {
+	PullRequestID     string        `json:"pull_request_id"`
+	PullRequestName   string        `json:"pull_request_name"`
+	AuthorID          string        `json:"author_id"`
+	Status            entity.Status `json:"status"`
+	AssignedReviewers []string      `json:"assigned_reviewers"`
+	CreatedAt         *time.Time    `json:"created_at,omitempty"`
+	MergedAt          *time.Time    `json:"merged_at,omitempty"`
+	Tags              []string      `json:"tags,omitempty"`
+}
+
+type User struct {
+	UserID   string `json:"user_id"`
+	UserName string `json:"username"`
+	TeamName string `json:"team_name"`
+	IsActive bool   `json:"is_active"`
+}
+
+func Encode(event *entity.Event) ([]byte, error) {
+	payload := Payload{ID: event.ID, Type: event.Type, OccurredAt: event.OccurredAt}
+
+	payload.Data.ReviewerIDs = event.ReviewerIDs
+	payload.Data.OldReviewerID = event.OldReviewerID
+
+	if pr := event.PullRequest; pr != nil {
+		payload.Data.PullRequest = &PullRequest{
+			PullRequestID:     pr.PullRequestID,
+			PullRequestName:   pr.PullRequestName,
+			AuthorID:          pr.AuthorID,
+			Status:            pr.Status,
+			AssignedReviewers: append([]string{}, pr.AssignedReviewers...),
+			CreatedAt:         pr.CreatedAt,
+			MergedAt:          pr.MergedAt,
+			Tags:              pr.Tags,
+		}
+	}
+
+	if user := event.User; user != nil {
+		payload.Data.User = &User{UserID: user.UserID, UserName: user.UserName, TeamName: user.TeamName, IsActive: user.IsActive}
+	}
+
+	return json.Marshal(payload)
+}
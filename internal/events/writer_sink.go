@@ -0,0 +1,43 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"pullrequest-service/internal/entity"
+	"sync"
+)
+
+type WriterSink struct {
+	name string
+	mu   sync.Mutex
+	w    io.Writer
+}
+
+func NewWriterSink(name string, w io.Writer) *WriterSink {
+	return &WriterSink{name: name, w: w}
+}
+
+func (s *WriterSink) Name() string {
+	return s.name
+}
+
+func (s *WriterSink) Transactional() bool {
+	return false
+}
+
+func (s *WriterSink) Deliver(_ context.Context, event entity.Event) error {
+	line, err := Encode(&event)
+	if err != nil {
+		return fmt.Errorf("encode event: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.w.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("write event: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"pullrequest-service/internal/entity"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	apiVersion       = "2022-11-28"
+	maxResponseBytes = 64 << 10
+)
+
+var pullRequestIDPattern = regexp.MustCompile(`^([\w.-]+/[\w.-]+)#(\d+)$`)
+
+type Client struct {
+	baseURL string
+	token   string
+	http    *http.Client
+}
+
+func NewClient(baseURL, token string, timeout time.Duration) *Client {
+	return &Client{baseURL: strings.TrimRight(baseURL, "/"), token: token, http: &http.Client{Timeout: timeout}}
+}
+
+func ParsePullRequestID(prId string) (string, int, bool) {
+	match := pullRequestIDPattern.FindStringSubmatch(prId)
+	if match == nil {
+		return "", 0, false
+	}
+
+	number, err := strconv.Atoi(match[2])
+	if err != nil || number <= 0 {
+		return "", 0, false
+	}
+
+	return match[1], number, true
+}
+
+func (c *Client) Provider() entity.Provider {
+	return entity.ProviderGitHub
+}
+
+func (c *Client) Owns(prId string) bool {
+	_, _, ok := ParsePullRequestID(prId)
+	return ok
+}
+
+func (c *Client) PublishReviewers(ctx context.Context, update *entity.ReviewerUpdate) error {
+	repository, number, ok := ParsePullRequestID(update.PullRequestID)
+	if !ok {
+		return fmt.Errorf("%w: not a GitHub pull request id %q", entity.ErrCodeHostRejected, update.PullRequestID)
+	}
+
+	path := fmt.Sprintf("/repos/%s/pulls/%d/requested_reviewers", repository, number)
+
+	if len(update.Removed) > 0 {
+		if err := c.do(ctx, http.MethodDelete, path, update.Removed); err != nil {
+			return fmt.Errorf("remove reviewers: %w", err)
+		}
+	}
+
+	if len(update.Reviewers) > 0 {
+		if err := c.do(ctx, http.MethodPost, path, update.Reviewers); err != nil {
+			return fmt.Errorf("request reviewers: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) do(ctx context.Context, method, path string, reviewers []string) error {
+	body, err := json.Marshal(map[string][]string{"reviewers": reviewers})
+	if err != nil {
+		return fmt.Errorf("marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("X-GitHub-Api-Version", apiVersion)
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	message, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+
+	return checkStatus(resp.StatusCode, message)
+}
+
+func checkStatus(status int, message []byte) error {
+	switch {
+	case status >= 200 && status < 300:
+		return nil
+	case status == http.StatusTooManyRequests || status == http.StatusRequestTimeout || status >= 500:
+		return fmt.Errorf("unexpected status %d: %s", status, bytes.TrimSpace(message))
+	default:
+		return fmt.Errorf("%w: status %d: %s", entity.ErrCodeHostRejected, status, bytes.TrimSpace(message))
+	}
+}
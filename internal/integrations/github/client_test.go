@@ -0,0 +1,95 @@
+package github_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"pullrequest-service/internal/entity"
+	"pullrequest-service/internal/integrations/github"
+	"pullrequest-service/internal/integrations/testutil"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestClientPublishReviewers(t *testing.T) {
+	host := testutil.NewFakeHost()
+	defer host.Close()
+
+	client := github.NewClient(host.URL, "token", time.Second)
+
+	update := &entity.ReviewerUpdate{PullRequestID: "acme/api#42", Reviewers: []string{"alice", "bob"}, Removed: []string{"carol"}}
+	if err := client.PublishReviewers(context.Background(), update); err != nil {
+		t.Fatalf("PublishReviewers() error = %v", err)
+	}
+
+	requests := host.Requests()
+	if len(requests) != 2 {
+		t.Fatalf("got %d requests, want 2", len(requests))
+	}
+
+	tests := []struct {
+		method    string
+		reviewers []string
+	}{
+		{method: http.MethodDelete, reviewers: []string{"carol"}},
+		{method: http.MethodPost, reviewers: []string{"alice", "bob"}},
+	}
+
+	for i, tt := range tests {
+		req := requests[i]
+
+		if req.Method != tt.method || req.Path != "/repos/acme/api/pulls/42/requested_reviewers" {
+			t.Errorf("request %d = %s %s, want %s on the PR reviewers", i, req.Method, req.Path, tt.method)
+		}
+
+		if got := req.Header.Get("Authorization"); got != "Bearer token" {
+			t.Errorf("request %d Authorization = %q", i, got)
+		}
+
+		var body struct {
+			Reviewers []string `json:"reviewers"`
+		}
+		if err := json.Unmarshal(req.Body, &body); err != nil {
+			t.Fatalf("request %d body: %v", i, err)
+		}
+
+		if !slices.Equal(body.Reviewers, tt.reviewers) {
+			t.Errorf("request %d reviewers = %v, want %v", i, body.Reviewers, tt.reviewers)
+		}
+	}
+}
+
+func TestClientPublishReviewersStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		rejected bool
+	}{
+		{name: "server error is transient", status: http.StatusBadGateway, rejected: false},
+		{name: "rate limit is transient", status: http.StatusTooManyRequests, rejected: false},
+		{name: "validation error is rejected", status: http.StatusUnprocessableEntity, rejected: true},
+		{name: "not found is rejected", status: http.StatusNotFound, rejected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			host := testutil.NewFakeHost()
+			defer host.Close()
+
+			host.FailNext(tt.status)
+
+			client := github.NewClient(host.URL, "token", time.Second)
+
+			err := client.PublishReviewers(context.Background(), &entity.ReviewerUpdate{PullRequestID: "acme/api#42", Reviewers: []string{"alice"}})
+			if err == nil {
+				t.Fatal("PublishReviewers() error = nil")
+			}
+
+			if got := errors.Is(err, entity.ErrCodeHostRejected); got != tt.rejected {
+				t.Errorf("errors.Is(err, ErrCodeHostRejected) = %v, want %v (err = %v)", got, tt.rejected, err)
+			}
+		})
+	}
+}
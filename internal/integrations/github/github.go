@@ -0,0 +1,79 @@
+package github
+
+import (
+	"crypto/hmac"
+	"encoding/json"
+	"fmt"
+	"pullrequest-service/internal/entity"
+	"pullrequest-service/internal/webhook"
+	"strconv"
+)
+
+const (
+	HeaderEvent      = "X-GitHub-Event"
+	HeaderDelivery   = "X-GitHub-Delivery"
+	HeaderSignature  = "X-Hub-Signature-256"
+	EventPing        = "ping"
+	EventPullRequest = "pull_request"
+)
+
+var actions = map[string]entity.ExternalPRAction{
+	"opened":             entity.ExternalPROpened,
+	"closed":             entity.ExternalPRClosed,
+	"reopened":           entity.ExternalPRReopened,
+	"converted_to_draft": entity.ExternalPRDraft,
+	"ready_for_review":   entity.ExternalPRReady,
+}
+
+type PullRequestPayload struct {
+	Action      string `json:"action"`
+	Number      int    `json:"number"`
+	PullRequest struct {
+		Title  string `json:"title"`
+		Merged bool   `json:"merged"`
+		Draft  bool   `json:"draft"`
+		User   struct {
+			Login string `json:"login"`
+		} `json:"user"`
+	} `json:"pull_request"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+}
+
+func VerifySignature(secret string, body []byte, signature string) bool {
+	if secret == "" || signature == "" {
+		return false
+	}
+	return hmac.Equal([]byte(webhook.Sign(secret, body)), []byte(signature))
+}
+
+func PullRequestID(repository string, number int) string {
+	return repository + "#" + strconv.Itoa(number)
+}
+
+func ParsePullRequestEvent(body []byte) (*entity.ExternalPREvent, error) {
+	var payload PullRequestPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("%w: invalid pull_request payload", entity.ErrInvalidRequest)
+	}
+
+	if payload.Repository.FullName == "" || payload.Number <= 0 {
+		return nil, fmt.Errorf("%w: pull_request payload without repository or number", entity.ErrInvalidRequest)
+	}
+
+	action, ok := actions[payload.Action]
+	if !ok {
+		action = entity.ExternalPRAction(payload.Action)
+	}
+
+	return &entity.ExternalPREvent{
+		Provider:      entity.ProviderGitHub,
+		Action:        action,
+		PullRequestID: PullRequestID(payload.Repository.FullName, payload.Number),
+		Title:         payload.PullRequest.Title,
+		AuthorLogin:   payload.PullRequest.User.Login,
+		Merged:        payload.PullRequest.Merged,
+		Draft:         payload.PullRequest.Draft,
+	}, nil
+}
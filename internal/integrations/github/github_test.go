@@ -0,0 +1,104 @@
+package github
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"pullrequest-service/internal/entity"
+	"pullrequest-service/internal/webhook"
+	"testing"
+)
+
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+
+	body, err := os.ReadFile(filepath.Join("..", "..", "..", "testdata", "github", name))
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	return body
+}
+
+func TestParsePullRequestEvent(t *testing.T) {
+	tests := []struct {
+		fixture string
+		action  entity.ExternalPRAction
+		merged  bool
+	}{
+		{fixture: "pull_request_opened.json", action: entity.ExternalPROpened},
+		{fixture: "pull_request_closed.json", action: entity.ExternalPRClosed},
+		{fixture: "pull_request_merged.json", action: entity.ExternalPRClosed, merged: true},
+		{fixture: "pull_request_reopened.json", action: entity.ExternalPRReopened},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			event, err := ParsePullRequestEvent(readFixture(t, tt.fixture))
+			if err != nil {
+				t.Fatalf("ParsePullRequestEvent() error = %v", err)
+			}
+
+			want := entity.ExternalPREvent{
+				Provider:      entity.ProviderGitHub,
+				Action:        tt.action,
+				PullRequestID: "acme/backend#42",
+				Title:         "Add rate limiting to public API",
+				AuthorLogin:   "Octo-Dev",
+				Merged:        tt.merged,
+			}
+
+			if *event != want {
+				t.Errorf("ParsePullRequestEvent() = %+v, want %+v", *event, want)
+			}
+		})
+	}
+}
+
+func TestParsePullRequestEventInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "not json", body: "action=opened"},
+		{name: "no repository", body: `{"action":"opened","number":1,"pull_request":{}}`},
+		{name: "no number", body: `{"action":"opened","repository":{"full_name":"acme/backend"}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParsePullRequestEvent([]byte(tt.body)); !errors.Is(err, entity.ErrInvalidRequest) {
+				t.Errorf("ParsePullRequestEvent() error = %v, want ErrInvalidRequest", err)
+			}
+		})
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	const secret = "gh-secret"
+
+	body := readFixture(t, "pull_request_opened.json")
+	tampered := append([]byte{}, body...)
+	tampered[len(tampered)-2] = ' '
+
+	tests := []struct {
+		name      string
+		secret    string
+		body      []byte
+		signature string
+		want      bool
+	}{
+		{name: "valid", secret: secret, body: body, signature: webhook.Sign(secret, body), want: true},
+		{name: "tampered body", secret: secret, body: tampered, signature: webhook.Sign(secret, body)},
+		{name: "wrong secret", secret: secret, body: body, signature: webhook.Sign("other", body)},
+		{name: "missing signature", secret: secret, body: body},
+		{name: "secret not configured", body: body, signature: webhook.Sign("", body)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VerifySignature(tt.secret, tt.body, tt.signature); got != tt.want {
+				t.Errorf("VerifySignature() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
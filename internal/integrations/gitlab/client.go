@@ -0,0 +1,195 @@
+package gitlab
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"pullrequest-service/internal/entity"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const maxResponseBytes = 64 << 10
+
+var pullRequestIDPattern = regexp.MustCompile(`^([\w.-]+(?:/[\w.-]+)+)!(\d+)$`)
+
+type Client struct {
+	baseURL string
+	token   string
+	http    *http.Client
+
+	mu        sync.Mutex
+	userIds   map[string]int64
+	usernames map[string]string
+}
+
+func NewClient(baseURL, token string, timeout time.Duration) *Client {
+	return &Client{
+		baseURL:   strings.TrimRight(baseURL, "/") + "/api/v4",
+		token:     token,
+		http:      &http.Client{Timeout: timeout},
+		userIds:   make(map[string]int64),
+		usernames: make(map[string]string),
+	}
+}
+
+func ParsePullRequestID(prId string) (string, int, bool) {
+	match := pullRequestIDPattern.FindStringSubmatch(prId)
+	if match == nil {
+		return "", 0, false
+	}
+
+	iid, err := strconv.Atoi(match[2])
+	if err != nil || iid <= 0 {
+		return "", 0, false
+	}
+
+	return match[1], iid, true
+}
+
+func (c *Client) Provider() entity.Provider {
+	return entity.ProviderGitLab
+}
+
+func (c *Client) Owns(prId string) bool {
+	_, _, ok := ParsePullRequestID(prId)
+	return ok
+}
+
+func (c *Client) PublishReviewers(ctx context.Context, update *entity.ReviewerUpdate) error {
+	project, iid, ok := ParsePullRequestID(update.PullRequestID)
+	if !ok {
+		return fmt.Errorf("%w: not a GitLab merge request id %q", entity.ErrCodeHostRejected, update.PullRequestID)
+	}
+
+	reviewerIds := make([]int64, 0, len(update.Reviewers))
+	for _, username := range update.Reviewers {
+		id, err := c.userId(ctx, username)
+		if err != nil {
+			return fmt.Errorf("resolve user %q: %w", username, err)
+		}
+		reviewerIds = append(reviewerIds, id)
+	}
+
+	path := fmt.Sprintf("/projects/%s/merge_requests/%d", url.PathEscape(project), iid)
+	body := map[string][]int64{"reviewer_ids": reviewerIds}
+
+	if err := c.do(ctx, http.MethodPut, path, body, nil); err != nil {
+		return fmt.Errorf("set reviewers: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) userId(ctx context.Context, username string) (int64, error) {
+	c.mu.Lock()
+	id, ok := c.userIds[username]
+	c.mu.Unlock()
+
+	if ok {
+		return id, nil
+	}
+
+	var users []struct {
+		ID int64 `json:"id"`
+	}
+
+	if err := c.do(ctx, http.MethodGet, "/users?username="+url.QueryEscape(username), nil, &users); err != nil {
+		return 0, err
+	}
+
+	if len(users) == 0 {
+		return 0, fmt.Errorf("%w: unknown user", entity.ErrCodeHostRejected)
+	}
+
+	c.mu.Lock()
+	c.userIds[username] = users[0].ID
+	c.mu.Unlock()
+
+	return users[0].ID, nil
+}
+
+func (c *Client) Username(ctx context.Context, userId string) (string, error) {
+	c.mu.Lock()
+	username, ok := c.usernames[userId]
+	c.mu.Unlock()
+
+	if ok {
+		return username, nil
+	}
+
+	var user struct {
+		Username string `json:"username"`
+	}
+
+	if err := c.do(ctx, http.MethodGet, "/users/"+url.PathEscape(userId), nil, &user); err != nil {
+		return "", err
+	}
+
+	if user.Username == "" {
+		return "", fmt.Errorf("%w: user %s has no username", entity.ErrCodeHostRejected, userId)
+	}
+
+	c.mu.Lock()
+	c.usernames[userId] = user.Username
+	c.mu.Unlock()
+
+	return user.Username, nil
+}
+
+func (c *Client) do(ctx context.Context, method, path string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		payload, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("marshal request: %w", err)
+		}
+		body = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("PRIVATE-TOKEN", c.token)
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	message, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+
+	if err := checkStatus(resp.StatusCode, message); err != nil {
+		return err
+	}
+
+	if out != nil {
+		if err := json.Unmarshal(message, out); err != nil {
+			return fmt.Errorf("decode response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func checkStatus(status int, message []byte) error {
+	switch {
+	case status >= 200 && status < 300:
+		return nil
+	case status == http.StatusTooManyRequests || status == http.StatusRequestTimeout || status >= 500:
+		return fmt.Errorf("unexpected status %d: %s", status, bytes.TrimSpace(message))
+	default:
+		return fmt.Errorf("%w: status %d: %s", entity.ErrCodeHostRejected, status, bytes.TrimSpace(message))
+	}
+}
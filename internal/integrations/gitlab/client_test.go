@@ -0,0 +1,133 @@
+package gitlab_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"pullrequest-service/internal/entity"
+	"pullrequest-service/internal/integrations/gitlab"
+	"pullrequest-service/internal/integrations/testutil"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestClientPublishReviewers(t *testing.T) {
+	host := testutil.NewFakeHost()
+	defer host.Close()
+
+	host.AddGitLabUser("alice", 11)
+	host.AddGitLabUser("bob", 12)
+
+	client := gitlab.NewClient(host.URL, "token", time.Second)
+	update := &entity.ReviewerUpdate{PullRequestID: "acme/backend/api!7", Reviewers: []string{"alice", "bob"}, Removed: []string{"carol"}}
+
+	for range 2 {
+		if err := client.PublishReviewers(context.Background(), update); err != nil {
+			t.Fatalf("PublishReviewers() error = %v", err)
+		}
+	}
+
+	var lookups, updates []testutil.Request
+	for _, req := range host.Requests() {
+		switch req.Method {
+		case http.MethodGet:
+			lookups = append(lookups, req)
+		case http.MethodPut:
+			updates = append(updates, req)
+		}
+	}
+
+	if len(lookups) != 2 {
+		t.Errorf("got %d user lookups, want 2 (user ids are cached)", len(lookups))
+	}
+
+	if len(updates) != 2 {
+		t.Fatalf("got %d merge request updates, want 2", len(updates))
+	}
+
+	req := updates[0]
+
+	if req.Path != "/api/v4/projects/acme%2Fbackend%2Fapi/merge_requests/7" {
+		t.Errorf("update path = %s", req.Path)
+	}
+
+	if got := req.Header.Get("PRIVATE-TOKEN"); got != "token" {
+		t.Errorf("PRIVATE-TOKEN = %q", got)
+	}
+
+	var body struct {
+		ReviewerIDs []int64 `json:"reviewer_ids"`
+	}
+	if err := json.Unmarshal(req.Body, &body); err != nil {
+		t.Fatalf("update body: %v", err)
+	}
+
+	if !slices.Equal(body.ReviewerIDs, []int64{11, 12}) {
+		t.Errorf("reviewer_ids = %v, want [11 12]", body.ReviewerIDs)
+	}
+}
+
+func TestClientPublishReviewersErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		reviewers []string
+		status    int
+		rejected  bool
+	}{
+		{name: "unknown user is rejected", reviewers: []string{"nobody"}, rejected: true},
+		{name: "server error is transient", reviewers: []string{"alice"}, status: http.StatusServiceUnavailable, rejected: false},
+		{name: "forbidden is rejected", reviewers: []string{"alice"}, status: http.StatusForbidden, rejected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			host := testutil.NewFakeHost()
+			defer host.Close()
+
+			host.AddGitLabUser("alice", 11)
+			if tt.status != 0 {
+				host.FailNext(tt.status)
+			}
+
+			client := gitlab.NewClient(host.URL, "token", time.Second)
+
+			err := client.PublishReviewers(context.Background(), &entity.ReviewerUpdate{PullRequestID: "acme/api!7", Reviewers: tt.reviewers})
+			if err == nil {
+				t.Fatal("PublishReviewers() error = nil")
+			}
+
+			if got := errors.Is(err, entity.ErrCodeHostRejected); got != tt.rejected {
+				t.Errorf("errors.Is(err, ErrCodeHostRejected) = %v, want %v (err = %v)", got, tt.rejected, err)
+			}
+		})
+	}
+}
+
+func TestClientUsername(t *testing.T) {
+	host := testutil.NewFakeHost()
+	defer host.Close()
+
+	host.AddGitLabUser("jane.doe", 58)
+
+	client := gitlab.NewClient(host.URL, "token", time.Second)
+
+	for range 2 {
+		username, err := client.Username(context.Background(), "58")
+		if err != nil {
+			t.Fatalf("Username() error = %v", err)
+		}
+		if username != "jane.doe" {
+			t.Errorf("Username() = %q, want jane.doe", username)
+		}
+	}
+
+	if got := len(host.Requests()); got != 1 {
+		t.Errorf("got %d user lookups, want 1 (usernames are cached)", got)
+	}
+
+	if _, err := client.Username(context.Background(), "99"); !errors.Is(err, entity.ErrCodeHostRejected) {
+		t.Errorf("Username(unknown) error = %v, want ErrCodeHostRejected", err)
+	}
+}
@@ -0,0 +1,112 @@
+package gitlab
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"pullrequest-service/internal/entity"
+	"strconv"
+)
+
+const (
+	HeaderEvent            = "X-Gitlab-Event"
+	HeaderToken            = "X-Gitlab-Token"
+	EventMergeRequest      = "Merge Request Hook"
+	objectKindMergeRequest = "merge_request"
+)
+
+type boolChange struct {
+	Previous bool `json:"previous"`
+	Current  bool `json:"current"`
+}
+
+type MergeRequestPayload struct {
+	ObjectKind string `json:"object_kind"`
+	User       struct {
+		Username string `json:"username"`
+	} `json:"user"`
+	Project struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		IID            int    `json:"iid"`
+		AuthorID       int64  `json:"author_id"`
+		Title          string `json:"title"`
+		Action         string `json:"action"`
+		Draft          bool   `json:"draft"`
+		WorkInProgress bool   `json:"work_in_progress"`
+	} `json:"object_attributes"`
+	Changes struct {
+		Draft          *boolChange `json:"draft"`
+		WorkInProgress *boolChange `json:"work_in_progress"`
+	} `json:"changes"`
+}
+
+func VerifyToken(secret, token string) bool {
+	if secret == "" || token == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(secret), []byte(token)) == 1
+}
+
+func PullRequestID(project string, iid int) string {
+	return project + "!" + strconv.Itoa(iid)
+}
+
+func ParseMergeRequestEvent(body []byte) (*entity.ExternalPREvent, error) {
+	var payload MergeRequestPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("%w: invalid merge_request payload", entity.ErrInvalidRequest)
+	}
+
+	attrs := payload.ObjectAttributes
+
+	if payload.ObjectKind != objectKindMergeRequest || payload.Project.PathWithNamespace == "" || attrs.IID <= 0 {
+		return nil, fmt.Errorf("%w: merge_request payload without project or iid", entity.ErrInvalidRequest)
+	}
+
+	event := &entity.ExternalPREvent{
+		Provider:      entity.ProviderGitLab,
+		PullRequestID: PullRequestID(payload.Project.PathWithNamespace, attrs.IID),
+		Title:         attrs.Title,
+		Draft:         attrs.Draft || attrs.WorkInProgress,
+	}
+
+	if attrs.AuthorID > 0 {
+		event.AuthorID = strconv.FormatInt(attrs.AuthorID, 10)
+	}
+
+	// The payload names only the user who triggered the event; for other
+	// actions the author is resolved from author_id.
+	switch attrs.Action {
+	case "open":
+		event.Action = entity.ExternalPROpened
+		event.AuthorLogin = payload.User.Username
+	case "reopen":
+		event.Action = entity.ExternalPRReopened
+	case "close":
+		event.Action = entity.ExternalPRClosed
+	case "merge":
+		event.Action = entity.ExternalPRClosed
+		event.Merged = true
+	case "update":
+		event.Action = draftAction(payload.Changes.Draft, payload.Changes.WorkInProgress)
+	default:
+		event.Action = entity.ExternalPRAction(attrs.Action)
+	}
+
+	return event, nil
+}
+
+func draftAction(changes ...*boolChange) entity.ExternalPRAction {
+	for _, change := range changes {
+		if change == nil || change.Previous == change.Current {
+			continue
+		}
+		if change.Current {
+			return entity.ExternalPRDraft
+		}
+		return entity.ExternalPRReady
+	}
+	return "update"
+}
@@ -0,0 +1,167 @@
+package gitlab
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"pullrequest-service/internal/entity"
+	"testing"
+)
+
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+
+	body, err := os.ReadFile(filepath.Join("..", "..", "..", "testdata", "gitlab", name))
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	return body
+}
+
+func TestParseMergeRequestEvent(t *testing.T) {
+	tests := []struct {
+		fixture string
+		want    entity.ExternalPREvent
+	}{
+		{
+			fixture: "merge_request_open.json",
+			want: entity.ExternalPREvent{Action: entity.ExternalPROpened, AuthorLogin: "jane.doe", AuthorID: "58",
+				Title: "Write audit log for admin actions"},
+		},
+		{
+			fixture: "merge_request_open_draft.json",
+			want: entity.ExternalPREvent{Action: entity.ExternalPROpened, AuthorLogin: "jane.doe", AuthorID: "58",
+				Title: "Draft: Write audit log for admin actions", Draft: true},
+		},
+		{
+			fixture: "merge_request_ready.json",
+			want:    entity.ExternalPREvent{Action: entity.ExternalPRReady, AuthorID: "58", Title: "Write audit log for admin actions"},
+		},
+		{
+			fixture: "merge_request_merge.json",
+			want:    entity.ExternalPREvent{Action: entity.ExternalPRClosed, AuthorID: "58", Title: "Write audit log for admin actions", Merged: true},
+		},
+		{
+			fixture: "merge_request_close.json",
+			want:    entity.ExternalPREvent{Action: entity.ExternalPRClosed, AuthorID: "58", Title: "Write audit log for admin actions"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			event, err := ParseMergeRequestEvent(readFixture(t, tt.fixture))
+			if err != nil {
+				t.Fatalf("ParseMergeRequestEvent() error = %v", err)
+			}
+
+			want := tt.want
+			want.Provider = entity.ProviderGitLab
+			want.PullRequestID = "platform/billing!17"
+
+			if *event != want {
+				t.Errorf("ParseMergeRequestEvent() = %+v, want %+v", *event, want)
+			}
+		})
+	}
+}
+
+func TestParseMergeRequestEventAuthor(t *testing.T) {
+	tests := []struct {
+		action    string
+		wantLogin string
+	}{
+		{action: "open", wantLogin: "jane.doe"},
+		{action: "reopen"},
+		{action: "update"},
+		{action: "close"},
+		{action: "merge"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.action, func(t *testing.T) {
+			body := `{"object_kind":"merge_request","user":{"id":58,"username":"jane.doe"},"project":{"path_with_namespace":"platform/billing"},` +
+				`"object_attributes":{"iid":17,"author_id":42,"action":"` + tt.action + `"}}`
+
+			event, err := ParseMergeRequestEvent([]byte(body))
+			if err != nil {
+				t.Fatalf("ParseMergeRequestEvent() error = %v", err)
+			}
+
+			if event.AuthorLogin != tt.wantLogin || event.AuthorID != "42" {
+				t.Errorf("author = (%q, %q), want (%q, %q)", event.AuthorLogin, event.AuthorID, tt.wantLogin, "42")
+			}
+		})
+	}
+}
+
+func TestParseMergeRequestEventDraftChanges(t *testing.T) {
+	tests := []struct {
+		name    string
+		changes string
+		want    entity.ExternalPRAction
+	}{
+		{name: "marked as draft", changes: `{"draft":{"previous":false,"current":true}}`, want: entity.ExternalPRDraft},
+		{name: "marked as ready", changes: `{"draft":{"previous":true,"current":false}}`, want: entity.ExternalPRReady},
+		{name: "legacy work in progress", changes: `{"work_in_progress":{"previous":false,"current":true}}`, want: entity.ExternalPRDraft},
+		{name: "title only", changes: `{"title":{"previous":"a","current":"b"}}`, want: "update"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := `{"object_kind":"merge_request","project":{"path_with_namespace":"platform/billing"},` +
+				`"object_attributes":{"iid":17,"action":"update"},"changes":` + tt.changes + `}`
+
+			event, err := ParseMergeRequestEvent([]byte(body))
+			if err != nil {
+				t.Fatalf("ParseMergeRequestEvent() error = %v", err)
+			}
+
+			if event.Action != tt.want {
+				t.Errorf("Action = %q, want %q", event.Action, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseMergeRequestEventInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "not json", body: "<xml/>"},
+		{name: "other object kind", body: `{"object_kind":"push","project":{"path_with_namespace":"platform/billing"},"object_attributes":{"iid":17}}`},
+		{name: "no project", body: `{"object_kind":"merge_request","object_attributes":{"iid":17}}`},
+		{name: "no iid", body: `{"object_kind":"merge_request","project":{"path_with_namespace":"platform/billing"}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseMergeRequestEvent([]byte(tt.body)); !errors.Is(err, entity.ErrInvalidRequest) {
+				t.Errorf("ParseMergeRequestEvent() error = %v, want ErrInvalidRequest", err)
+			}
+		})
+	}
+}
+
+func TestVerifyToken(t *testing.T) {
+	tests := []struct {
+		name   string
+		secret string
+		token  string
+		want   bool
+	}{
+		{name: "valid", secret: "gl-token", token: "gl-token", want: true},
+		{name: "wrong token", secret: "gl-token", token: "gl-tokem"},
+		{name: "prefix of the token", secret: "gl-token", token: "gl-"},
+		{name: "missing token", secret: "gl-token"},
+		{name: "secret not configured", token: "gl-token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VerifyToken(tt.secret, tt.token); got != tt.want {
+				t.Errorf("VerifyToken() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
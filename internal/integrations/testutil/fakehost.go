@@ -0,0 +1,116 @@
+// Package testutil provides a fake GitHub and GitLab API for tests of the
+// code host clients and the reviewer sync.
+package testutil
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"sync"
+)
+
+var (
+	githubReviewersPath = regexp.MustCompile(`^/repos/[^/]+/[^/]+/pulls/\d+/requested_reviewers$`)
+	gitlabMergeRequest  = regexp.MustCompile(`^/api/v4/projects/[^/]+/merge_requests/\d+$`)
+	gitlabUserPath      = regexp.MustCompile(`^/api/v4/users/(\d+)$`)
+)
+
+type Request struct {
+	Method string
+	Path   string
+	Header http.Header
+	Body   []byte
+}
+
+type FakeHost struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	requests    []Request
+	gitlabUsers map[string]int64
+	failures    []int
+}
+
+func NewFakeHost() *FakeHost {
+	s := &FakeHost{gitlabUsers: make(map[string]int64)}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+func (s *FakeHost) AddGitLabUser(username string, id int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.gitlabUsers[username] = id
+}
+
+func (s *FakeHost) FailNext(statuses ...int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = append(s.failures, statuses...)
+}
+
+func (s *FakeHost) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request{}, s.requests...)
+}
+
+func (s *FakeHost) handle(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	path := r.URL.EscapedPath()
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{Method: r.Method, Path: r.URL.RequestURI(), Header: r.Header.Clone(), Body: body})
+
+	if len(s.failures) > 0 {
+		status := s.failures[0]
+		s.failures = s.failures[1:]
+		s.mu.Unlock()
+		http.Error(w, http.StatusText(status), status)
+		return
+	}
+	s.mu.Unlock()
+
+	switch {
+	case githubReviewersPath.MatchString(path) && r.Method == http.MethodPost:
+		writeJSON(w, http.StatusCreated, map[string]any{})
+	case githubReviewersPath.MatchString(path) && r.Method == http.MethodDelete:
+		writeJSON(w, http.StatusOK, map[string]any{})
+	case path == "/api/v4/users" && r.Method == http.MethodGet:
+		s.mu.Lock()
+		id, ok := s.gitlabUsers[r.URL.Query().Get("username")]
+		s.mu.Unlock()
+
+		users := []map[string]int64{}
+		if ok {
+			users = append(users, map[string]int64{"id": id})
+		}
+		writeJSON(w, http.StatusOK, users)
+	case gitlabUserPath.MatchString(path) && r.Method == http.MethodGet:
+		id := gitlabUserPath.FindStringSubmatch(path)[1]
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		for username, userId := range s.gitlabUsers {
+			if strconv.FormatInt(userId, 10) == id {
+				writeJSON(w, http.StatusOK, map[string]any{"id": userId, "username": username})
+				return
+			}
+		}
+		http.NotFound(w, r)
+	case gitlabMergeRequest.MatchString(path) && r.Method == http.MethodPut:
+		writeJSON(w, http.StatusOK, map[string]any{})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
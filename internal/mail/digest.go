@@ -0,0 +1,64 @@
+package mail
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"pullrequest-service/internal/entity"
+	texttemplate "text/template"
+	"time"
+)
+
+//go:embed templates
+var templates embed.FS
+
+var funcs = map[string]any{
+	"age": formatDuration,
+	"sla": formatSLA,
+}
+
+var (
+	textDigest = texttemplate.Must(texttemplate.New("digest.txt").Funcs(funcs).ParseFS(templates, "templates/digest.txt"))
+	htmlDigest = htmltemplate.Must(htmltemplate.New("digest.html").Funcs(funcs).ParseFS(templates, "templates/digest.html"))
+)
+
+func RenderDigest(digest *entity.ReviewDigest) (string, string, error) {
+	var text, html bytes.Buffer
+
+	if err := textDigest.Execute(&text, digest); err != nil {
+		return "", "", fmt.Errorf("render text digest: %w", err)
+	}
+
+	if err := htmlDigest.Execute(&html, digest); err != nil {
+		return "", "", fmt.Errorf("render html digest: %w", err)
+	}
+
+	return text.String(), html.String(), nil
+}
+
+func formatDuration(d time.Duration) string {
+	d = d.Round(time.Minute)
+	days := d / (24 * time.Hour)
+	hours := (d % (24 * time.Hour)) / time.Hour
+
+	switch {
+	case days > 0:
+		return fmt.Sprintf("%dd %dh", days, hours)
+	case hours > 0:
+		return fmt.Sprintf("%dh %dm", hours, (d%time.Hour)/time.Minute)
+	default:
+		return fmt.Sprintf("%dm", d/time.Minute)
+	}
+}
+
+func formatSLA(review entity.DigestReview) string {
+	switch review.SLAStatus {
+	case entity.SLAStatusOverdue:
+		return "SLA overdue"
+	case entity.SLAStatusOnTrack:
+		return "SLA due in " + formatDuration(review.SLADueIn) + " working time"
+	default:
+		return "no SLA"
+	}
+}
@@ -0,0 +1,70 @@
+package mail
+
+import (
+	"pullrequest-service/internal/entity"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testDigest() *entity.ReviewDigest {
+	return &entity.ReviewDigest{
+		User: entity.User{UserID: "u1", UserName: "Alice", Email: "alice@example.com"},
+		Reviews: []entity.DigestReview{
+			{PullRequestID: "pr-1", PullRequestName: "Add <search>", AuthorID: "u2", Age: 26 * time.Hour, SLAStatus: entity.SLAStatusOverdue},
+			{PullRequestID: "pr-2", PullRequestName: "Fix login", AuthorID: "u3", Age: 90 * time.Minute, SLAStatus: entity.SLAStatusOnTrack,
+				SLADueIn: 3 * time.Hour},
+			{PullRequestID: "pr-3", PullRequestName: "Bump deps", AuthorID: "u4", Age: 5 * time.Minute},
+		},
+		GeneratedAt: time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestRenderDigest(t *testing.T) {
+	text, html, err := RenderDigest(testDigest())
+	if err != nil {
+		t.Fatalf("RenderDigest() error = %v", err)
+	}
+
+	for _, want := range []string{
+		"Hello, Alice!",
+		"You have 3 pending review(s):",
+		"- Add <search> (pr-1) by u2\n  assigned 1d 2h ago, SLA overdue",
+		"- Fix login (pr-2) by u3\n  assigned 1h 30m ago, SLA due in 3h 0m working time",
+		"- Bump deps (pr-3) by u4\n  assigned 5m ago, no SLA",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("text digest does not contain %q:\n%s", want, text)
+		}
+	}
+
+	for _, want := range []string{
+		"<p>Hello, Alice!</p>",
+		"<td>Add &lt;search&gt;<br><small>pr-1</small></td>",
+		`<td style="color: #c00; font-weight: bold">SLA overdue</td>`,
+		"<td>no SLA</td>",
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("html digest does not contain %q:\n%s", want, html)
+		}
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{d: 0, want: "0m"},
+		{d: 59 * time.Second, want: "1m"},
+		{d: 45 * time.Minute, want: "45m"},
+		{d: 2*time.Hour + 5*time.Minute, want: "2h 5m"},
+		{d: 49 * time.Hour, want: "2d 1h"},
+	}
+
+	for _, tt := range tests {
+		if got := formatDuration(tt.d); got != tt.want {
+			t.Errorf("formatDuration(%s) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
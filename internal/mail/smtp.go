@@ -0,0 +1,139 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"pullrequest-service/internal/entity"
+	"strconv"
+	"time"
+)
+
+type SMTPSender struct {
+	host     string
+	addr     string
+	username string
+	password string
+	from     string
+	startTLS bool
+	timeout  time.Duration
+}
+
+func NewSMTPSender(host string, port int, username, password, from string, startTLS bool, timeout time.Duration) *SMTPSender {
+	return &SMTPSender{host: host, addr: net.JoinHostPort(host, strconv.Itoa(port)), username: username, password: password, from: from,
+		startTLS: startTLS, timeout: timeout}
+}
+
+func (s *SMTPSender) SendDigest(ctx context.Context, digest *entity.ReviewDigest) error {
+	text, html, err := RenderDigest(digest)
+	if err != nil {
+		return err
+	}
+
+	subject := fmt.Sprintf("Pending reviews: %d", len(digest.Reviews))
+
+	msg, err := buildMessage(s.from, digest.User.Email, subject, text, html, digest.GeneratedAt)
+	if err != nil {
+		return err
+	}
+
+	return s.send(ctx, digest.User.Email, msg)
+}
+
+func (s *SMTPSender) send(ctx context.Context, to string, msg []byte) error {
+	dialer := &net.Dialer{Timeout: s.timeout}
+
+	conn, err := dialer.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return fmt.Errorf("dial smtp: %w", err)
+	}
+
+	if err := conn.SetDeadline(time.Now().Add(s.timeout)); err != nil {
+		conn.Close()
+		return fmt.Errorf("set smtp deadline: %w", err)
+	}
+
+	client, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("smtp handshake: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok && s.startTLS {
+		if err := client.StartTLS(&tls.Config{ServerName: s.host}); err != nil {
+			return fmt.Errorf("smtp starttls: %w", err)
+		}
+	}
+
+	if s.username != "" {
+		if err := client.Auth(smtp.PlainAuth("", s.username, s.password, s.host)); err != nil {
+			return fmt.Errorf("smtp auth: %w", err)
+		}
+	}
+
+	if err := client.Mail(s.from); err != nil {
+		return fmt.Errorf("smtp mail from: %w", err)
+	}
+
+	if err := client.Rcpt(to); err != nil {
+		return fmt.Errorf("smtp rcpt to: %w", err)
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("smtp data: %w", err)
+	}
+
+	if _, err := w.Write(msg); err != nil {
+		return fmt.Errorf("smtp write message: %w", err)
+	}
+
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("smtp send message: %w", err)
+	}
+
+	return client.Quit()
+}
+
+func buildMessage(from, to, subject, text, html string, date time.Time) ([]byte, error) {
+	boundaryBytes := make([]byte, 16)
+	if _, err := rand.Read(boundaryBytes); err != nil {
+		return nil, fmt.Errorf("generate boundary: %w", err)
+	}
+	boundary := hex.EncodeToString(boundaryBytes)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", to)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	fmt.Fprintf(&buf, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&buf, "Content-Type: multipart/alternative; boundary=%q\r\n\r\n", boundary)
+
+	for _, part := range []struct{ contentType, body string }{{"text/plain", text}, {"text/html", html}} {
+		fmt.Fprintf(&buf, "--%s\r\n", boundary)
+		fmt.Fprintf(&buf, "Content-Type: %s; charset=utf-8\r\n", part.contentType)
+		fmt.Fprintf(&buf, "Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+
+		qp := quotedprintable.NewWriter(&buf)
+		if _, err := qp.Write([]byte(part.body)); err != nil {
+			return nil, fmt.Errorf("encode %s part: %w", part.contentType, err)
+		}
+		if err := qp.Close(); err != nil {
+			return nil, fmt.Errorf("encode %s part: %w", part.contentType, err)
+		}
+		buf.WriteString("\r\n")
+	}
+
+	fmt.Fprintf(&buf, "--%s--\r\n", boundary)
+
+	return buf.Bytes(), nil
+}
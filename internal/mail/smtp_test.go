@@ -0,0 +1,195 @@
+package mail
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	netmail "net/mail"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+type smtpSession struct {
+	from string
+	rcpt []string
+	data string
+}
+
+// fakeSMTP accepts a single SMTP session without TLS or auth and reports what it received.
+func fakeSMTP(t *testing.T) (string, int, <-chan smtpSession) {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	sessions := make(chan smtpSession, 1)
+
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		r := bufio.NewReader(conn)
+		reply := func(line string) { io.WriteString(conn, line+"\r\n") }
+
+		var session smtpSession
+		reply("220 localhost ESMTP")
+
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			line = strings.TrimRight(line, "\r\n")
+			verb := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+
+			switch {
+			case verb == "EHLO" || verb == "HELO":
+				reply("250 localhost")
+			case strings.HasPrefix(strings.ToUpper(line), "MAIL FROM:"):
+				session.from = line[len("MAIL FROM:"):]
+				reply("250 OK")
+			case strings.HasPrefix(strings.ToUpper(line), "RCPT TO:"):
+				session.rcpt = append(session.rcpt, line[len("RCPT TO:"):])
+				reply("250 OK")
+			case verb == "DATA":
+				reply("354 End data with <CR><LF>.<CR><LF>")
+				var data strings.Builder
+				for {
+					dataLine, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if dataLine == ".\r\n" {
+						break
+					}
+					data.WriteString(dataLine)
+				}
+				session.data = data.String()
+				reply("250 OK")
+			case verb == "QUIT":
+				reply("221 Bye")
+				sessions <- session
+				return
+			default:
+				reply("502 Command not implemented")
+			}
+		}
+	}()
+
+	host, port, _ := net.SplitHostPort(ln.Addr().String())
+	portNumber, _ := strconv.Atoi(port)
+
+	return host, portNumber, sessions
+}
+
+func TestSMTPSenderSendDigest(t *testing.T) {
+	host, port, sessions := fakeSMTP(t)
+
+	sender := NewSMTPSender(host, port, "", "", "pr-service@example.com", true, time.Second)
+
+	if err := sender.SendDigest(context.Background(), testDigest()); err != nil {
+		t.Fatalf("SendDigest() error = %v", err)
+	}
+
+	var session smtpSession
+	select {
+	case session = <-sessions:
+	case <-time.After(time.Second):
+		t.Fatal("fake SMTP server received no session")
+	}
+
+	if session.from != "<pr-service@example.com>" {
+		t.Errorf("MAIL FROM = %q", session.from)
+	}
+
+	if len(session.rcpt) != 1 || session.rcpt[0] != "<alice@example.com>" {
+		t.Errorf("RCPT TO = %q", session.rcpt)
+	}
+
+	msg, err := netmail.ReadMessage(strings.NewReader(session.data))
+	if err != nil {
+		t.Fatalf("read message: %v", err)
+	}
+
+	if got := msg.Header.Get("To"); got != "alice@example.com" {
+		t.Errorf("To = %q", got)
+	}
+
+	if got := msg.Header.Get("Subject"); got != "Pending reviews: 3" {
+		t.Errorf("Subject = %q", got)
+	}
+}
+
+func TestBuildMessage(t *testing.T) {
+	date := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	text := "Привет, " + strings.Repeat("long line ", 20)
+
+	raw, err := buildMessage("from@example.com", "to@example.com", "Pending reviews: 2", text, "<p>hi</p>", date)
+	if err != nil {
+		t.Fatalf("buildMessage() error = %v", err)
+	}
+
+	msg, err := netmail.ReadMessage(strings.NewReader(string(raw)))
+	if err != nil {
+		t.Fatalf("read message: %v", err)
+	}
+
+	headers := map[string]string{
+		"From":         "from@example.com",
+		"To":           "to@example.com",
+		"Date":         "Mon, 19 Oct 2026 09:00:00 +0000",
+		"MIME-Version": "1.0",
+	}
+	for name, want := range headers {
+		if got := msg.Header.Get(name); got != want {
+			t.Errorf("%s = %q, want %q", name, got, want)
+		}
+	}
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil || subject != "Pending reviews: 2" {
+		t.Errorf("Subject = %q, %v", subject, err)
+	}
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/alternative" {
+		t.Fatalf("Content-Type = %q, %v", mediaType, err)
+	}
+
+	parts := multipart.NewReader(msg.Body, params["boundary"])
+
+	for _, want := range []struct{ contentType, body string }{{"text/plain; charset=utf-8", text}, {"text/html; charset=utf-8", "<p>hi</p>"}} {
+		part, err := parts.NextPart()
+		if err != nil {
+			t.Fatalf("next part: %v", err)
+		}
+
+		body, err := io.ReadAll(part)
+		if err != nil {
+			t.Fatalf("read part: %v", err)
+		}
+
+		if got := part.Header.Get("Content-Type"); got != want.contentType {
+			t.Errorf("part Content-Type = %q, want %q", got, want.contentType)
+		}
+
+		if got := strings.TrimSuffix(string(body), "\r\n"); got != want.body {
+			t.Errorf("part body = %q, want %q", got, want.body)
+		}
+	}
+
+	if _, err := parts.NextPart(); err != io.EOF {
+		t.Errorf("expected exactly two parts, got err = %v", err)
+	}
+}
@@ -0,0 +1,275 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"pullrequest-service/internal/entity"
+
+	"github.com/Masterminds/squirrel"
+)
+
+type PostgresAccountRepository struct {
+	db *sql.DB
+	sq squirrel.StatementBuilderType
+}
+
+func NewPostgresAccountRepository(db *sql.DB) *PostgresAccountRepository {
+	return &PostgresAccountRepository{db: db, sq: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)}
+}
+
+func (r *PostgresAccountRepository) SetAccount(ctx context.Context, account *entity.ExternalAccount) error {
+	query, args, err := r.sq.Insert("external_accounts").Columns("provider", "login", "user_id").
+		Values(account.Provider, account.Login, account.UserID).
+		Suffix("ON CONFLICT (provider, login) DO UPDATE SET user_id = EXCLUDED.user_id").ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build upsert external account: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	_, err = exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return fmt.Errorf("user for external account: %w", entity.ErrNotFound)
+		}
+		return fmt.Errorf("exec upsert external account: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresAccountRepository) GetUserIdByLogin(ctx context.Context, provider entity.Provider, login string) (string, error) {
+	query, args, err := r.sq.Select("user_id").From("external_accounts").
+		Where(squirrel.Eq{"provider": provider, "login": login}).ToSql()
+
+	if err != nil {
+		return "", fmt.Errorf("failed to build select external account: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	var userId string
+	if err := exec.QueryRowContext(ctx, query, args...).Scan(&userId); err != nil {
+		if err == sql.ErrNoRows {
+			return "", fmt.Errorf("external account: %w", entity.ErrNotFound)
+		}
+		return "", fmt.Errorf("exec select external account: %w", err)
+	}
+
+	return userId, nil
+}
+
+func (r *PostgresAccountRepository) ListAccounts(ctx context.Context, provider entity.Provider) ([]entity.ExternalAccount, error) {
+	builder := r.sq.Select("provider", "login", "user_id").From("external_accounts").OrderBy("provider", "login")
+
+	if provider != "" {
+		builder = builder.Where(squirrel.Eq{"provider": provider})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select external accounts: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec select external accounts: %w", err)
+	}
+	defer rows.Close()
+
+	accounts := make([]entity.ExternalAccount, 0)
+	for rows.Next() {
+		var account entity.ExternalAccount
+		if err := rows.Scan(&account.Provider, &account.Login, &account.UserID); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		accounts = append(accounts, account)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return accounts, nil
+}
+
+func (r *PostgresAccountRepository) DeleteAccount(ctx context.Context, provider entity.Provider, login string) error {
+	query, args, err := r.sq.Delete("external_accounts").Where(squirrel.Eq{"provider": provider, "login": login}).ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build delete external account: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	res, err := exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec delete external account: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected: %w", err)
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("external account: %w", entity.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *PostgresAccountRepository) QueueUnmapped(ctx context.Context, login string, event *entity.ExternalPREvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal event: %w", err)
+	}
+
+	query, args, err := r.sq.Insert("unmapped_events").Columns("provider", "login", "pull_request_id", "event").
+		Values(event.Provider, login, event.PullRequestID, payload).ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build insert unmapped event: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	_, err = exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec insert unmapped event: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresAccountRepository) GetUnmappedLoginByPR(ctx context.Context, provider entity.Provider, prId string) (string, error) {
+	query, args, err := r.sq.Select("login").From("unmapped_events").
+		Where(squirrel.Eq{"provider": provider, "pull_request_id": prId}).OrderBy("id").Limit(1).ToSql()
+
+	if err != nil {
+		return "", fmt.Errorf("failed to build select unmapped login: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	var login string
+	if err := exec.QueryRowContext(ctx, query, args...).Scan(&login); err != nil {
+		if err == sql.ErrNoRows {
+			return "", fmt.Errorf("unmapped event: %w", entity.ErrNotFound)
+		}
+		return "", fmt.Errorf("exec select unmapped login: %w", err)
+	}
+
+	return login, nil
+}
+
+func (r *PostgresAccountRepository) ListUnmapped(ctx context.Context, provider entity.Provider, login string) ([]entity.UnmappedEvent, error) {
+	builder := r.sq.Select("id", "login", "event", "created_at").From("unmapped_events").OrderBy("id")
+
+	if provider != "" {
+		builder = builder.Where(squirrel.Eq{"provider": provider})
+	}
+
+	if login != "" {
+		builder = builder.Where(squirrel.Eq{"login": login})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select unmapped events: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec select unmapped events: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]entity.UnmappedEvent, 0)
+	for rows.Next() {
+		var event entity.UnmappedEvent
+		var payload []byte
+		if err := rows.Scan(&event.ID, &event.Login, &payload, &event.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		if err := json.Unmarshal(payload, &event.Event); err != nil {
+			return nil, fmt.Errorf("unmarshal event: %w", err)
+		}
+
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return events, nil
+}
+
+func (r *PostgresAccountRepository) DeleteUnmapped(ctx context.Context, id int64) error {
+	query, args, err := r.sq.Delete("unmapped_events").Where(squirrel.Eq{"id": id}).ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build delete unmapped event: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	res, err := exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec delete unmapped event: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected: %w", err)
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("unmapped event: %w", entity.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *PostgresAccountRepository) GetLoginsByUsers(ctx context.Context, provider entity.Provider, userIds []string) (map[string]string, error) {
+	query, args, err := r.sq.Select("user_id", "login").From("external_accounts").
+		Where(squirrel.Eq{"provider": provider, "user_id": userIds}).OrderBy("login").ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select external logins: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec select external logins: %w", err)
+	}
+	defer rows.Close()
+
+	logins := make(map[string]string, len(userIds))
+	for rows.Next() {
+		var userId, login string
+		if err := rows.Scan(&userId, &login); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		if _, ok := logins[userId]; !ok {
+			logins[userId] = login
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return logins, nil
+}
@@ -0,0 +1,172 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"pullrequest-service/internal/entity"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+)
+
+type PostgresAvailabilityRepository struct {
+	db *sql.DB
+	sq squirrel.StatementBuilderType
+}
+
+func NewPostgresAvailabilityRepository(db *sql.DB) *PostgresAvailabilityRepository {
+	return &PostgresAvailabilityRepository{db: db, sq: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)}
+
+}
+
+func (r *PostgresAvailabilityRepository) CreateUnavailability(ctx context.Context, u *entity.Unavailability) (int64, error) {
+	query, args, err := r.sq.Insert("user_unavailability").Columns("user_id", "starts_at", "ends_at", "reason", "reassign_reviews").
+		Values(u.UserID, u.StartsAt, u.EndsAt, u.Reason, u.ReassignReviews).Suffix("RETURNING id").ToSql()
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to build insert unavailability: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	var id int64
+	if err := exec.QueryRowContext(ctx, query, args...).Scan(&id); err != nil {
+		if isForeignKeyViolation(err) {
+			return 0, fmt.Errorf("user for unavailability: %w", entity.ErrNotFound)
+		}
+		return 0, fmt.Errorf("exec insert unavailability: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *PostgresAvailabilityRepository) GetUnavailabilitiesByUser(ctx context.Context, userId string) ([]entity.Unavailability, error) {
+	query, args, err := r.sq.Select(unavailabilityColumns...).From("user_unavailability").
+		Where(squirrel.Eq{"user_id": userId}).OrderBy("starts_at").ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select unavailability by user: %w", err)
+	}
+
+	return r.queryUnavailabilities(ctx, query, args)
+}
+
+func (r *PostgresAvailabilityRepository) DeleteUnavailability(ctx context.Context, id int64) error {
+	query, args, err := r.sq.Delete("user_unavailability").Where(squirrel.Eq{"id": id}).ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build delete unavailability: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	res, err := exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec delete unavailability: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected: %w", err)
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("unavailability: %w", entity.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *PostgresAvailabilityRepository) GetUnavailableUsersByTeam(ctx context.Context, teamName string, at time.Time) ([]string, error) {
+	query, args, err := r.sq.Select("DISTINCT uu.user_id").From("user_unavailability uu").
+		Join("users u ON u.user_id = uu.user_id").
+		Where(squirrel.Eq{"u.team_name": teamName}).
+		Where(squirrel.LtOrEq{"uu.starts_at": at}).
+		Where(squirrel.Gt{"uu.ends_at": at}).ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select unavailable users: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec select unavailable users: %w", err)
+	}
+	defer rows.Close()
+
+	users := make([]string, 0)
+	for rows.Next() {
+		var userId string
+		if err := rows.Scan(&userId); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		users = append(users, userId)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return users, nil
+}
+
+func (r *PostgresAvailabilityRepository) GetStartedPendingReassignments(ctx context.Context, at time.Time) ([]entity.Unavailability, error) {
+	query, args, err := r.sq.Select(unavailabilityColumns...).From("user_unavailability").
+		Where(squirrel.Eq{"reassign_reviews": true, "reviews_reassigned_at": nil}).
+		Where(squirrel.LtOrEq{"starts_at": at}).
+		Where(squirrel.Gt{"ends_at": at}).OrderBy("starts_at").ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select pending reassignments: %w", err)
+	}
+
+	return r.queryUnavailabilities(ctx, query, args)
+}
+
+func (r *PostgresAvailabilityRepository) MarkReviewsReassigned(ctx context.Context, id int64, at time.Time) error {
+	query, args, err := r.sq.Update("user_unavailability").Set("reviews_reassigned_at", at).
+		Where(squirrel.Eq{"id": id}).ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build mark reviews reassigned: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	_, err = exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec mark reviews reassigned: %w", err)
+	}
+
+	return nil
+}
+
+var unavailabilityColumns = []string{"id", "user_id", "starts_at", "ends_at", "reason", "reassign_reviews", "reviews_reassigned_at"}
+
+func (r *PostgresAvailabilityRepository) queryUnavailabilities(ctx context.Context, query string, args []interface{}) ([]entity.Unavailability, error) {
+	exec := executerFromContext(ctx, r.db)
+
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec select unavailability: %w", err)
+	}
+	defer rows.Close()
+
+	windows := make([]entity.Unavailability, 0)
+	for rows.Next() {
+		var w entity.Unavailability
+		if err := rows.Scan(&w.ID, &w.UserID, &w.StartsAt, &w.EndsAt, &w.Reason, &w.ReassignReviews, &w.ReviewsReassignedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan unavailability row: %w", err)
+		}
+		windows = append(windows, w)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return windows, nil
+}
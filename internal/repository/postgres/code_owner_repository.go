@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"pullrequest-service/internal/entity"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+)
+
+type PostgresCodeOwnerRepository struct {
+	db *sql.DB
+	sq squirrel.StatementBuilderType
+}
+
+func NewPostgresCodeOwnerRepository(db *sql.DB) *PostgresCodeOwnerRepository {
+	return &PostgresCodeOwnerRepository{db: db, sq: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)}
+
+}
+
+func (r *PostgresCodeOwnerRepository) CreateRule(ctx context.Context, rule *entity.CodeOwnerRule) (int64, error) {
+	query, args, err := r.sq.Insert("code_owner_rules").Columns("team_name", "pattern", "owner_users", "owner_teams").
+		Values(rule.TeamName, rule.Pattern, stringArray(rule.OwnerUsers), stringArray(rule.OwnerTeams)).
+		Suffix("RETURNING id").ToSql()
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to build insert code owner rule: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	var id int64
+	if err := exec.QueryRowContext(ctx, query, args...).Scan(&id); err != nil {
+		if isForeignKeyViolation(err) {
+			return 0, fmt.Errorf("team for code owner rule: %w", entity.ErrNotFound)
+		}
+		return 0, fmt.Errorf("exec insert code owner rule: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *PostgresCodeOwnerRepository) GetRulesByTeam(ctx context.Context, teamName string) ([]entity.CodeOwnerRule, error) {
+	query, args, err := r.sq.Select("id", "team_name", "pattern", "owner_users", "owner_teams").From("code_owner_rules").
+		Where(squirrel.Eq{"team_name": teamName}).OrderBy("id").ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select code owner rules: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec select code owner rules: %w", err)
+	}
+	defer rows.Close()
+
+	rules := make([]entity.CodeOwnerRule, 0)
+	for rows.Next() {
+		var rule entity.CodeOwnerRule
+		if err := rows.Scan(&rule.ID, &rule.TeamName, &rule.Pattern, pq.Array(&rule.OwnerUsers), pq.Array(&rule.OwnerTeams)); err != nil {
+			return nil, fmt.Errorf("failed to scan code owner rule row: %w", err)
+		}
+		rules = append(rules, rule)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return rules, nil
+}
+
+func (r *PostgresCodeOwnerRepository) DeleteRule(ctx context.Context, id int64) error {
+	query, args, err := r.sq.Delete("code_owner_rules").Where(squirrel.Eq{"id": id}).ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build delete code owner rule: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	res, err := exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec delete code owner rule: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected: %w", err)
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("code owner rule: %w", entity.ErrNotFound)
+	}
+
+	return nil
+}
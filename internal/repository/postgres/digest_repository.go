@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+)
+
+type PostgresDigestRepository struct {
+	db *sql.DB
+	sq squirrel.StatementBuilderType
+}
+
+func NewPostgresDigestRepository(db *sql.DB) *PostgresDigestRepository {
+	return &PostgresDigestRepository{db: db, sq: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)}
+}
+
+func (r *PostgresDigestRepository) ClaimDigest(ctx context.Context, userId, date string) (bool, error) {
+	query, args, err := r.sq.Insert("email_digests").Columns("user_id", "digest_date").Values(userId, date).
+		Suffix("ON CONFLICT (user_id, digest_date) DO NOTHING").ToSql()
+
+	if err != nil {
+		return false, fmt.Errorf("failed to build claim digest: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	res, err := exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("exec claim digest: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("rows affected: %w", err)
+	}
+
+	return affected > 0, nil
+}
+
+func (r *PostgresDigestRepository) ReleaseDigest(ctx context.Context, userId, date string) error {
+	query, args, err := r.sq.Delete("email_digests").Where(squirrel.Eq{"user_id": userId, "digest_date": date}).ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build release digest: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	_, err = exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec release digest: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,299 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"pullrequest-service/internal/entity"
+	"strconv"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+)
+
+const (
+	feedBatchSize         = 500
+	feedMinReconnectDelay = time.Second
+	feedMaxReconnectDelay = time.Minute
+	feedRecentIds         = 4096
+	feedMaxGaps           = 1024
+	feedGapTimeout        = time.Minute
+)
+
+type EventFeed struct {
+	db           *sql.DB
+	sq           squirrel.StatementBuilderType
+	connStr      string
+	pollInterval time.Duration
+	logger       *slog.Logger
+}
+
+func NewEventFeed(db *sql.DB, connStr string, pollInterval time.Duration, logger *slog.Logger) *EventFeed {
+	return &EventFeed{db: db, sq: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar), connStr: connStr, pollInterval: pollInterval,
+		logger: logger}
+}
+
+type recentIds struct {
+	seen  map[int64]struct{}
+	order []int64
+}
+
+func (r *recentIds) add(id int64) {
+	if len(r.order) == feedRecentIds {
+		delete(r.seen, r.order[0])
+		r.order = r.order[1:]
+	}
+	r.seen[id] = struct{}{}
+	r.order = append(r.order, id)
+}
+
+func (r *recentIds) has(id int64) bool {
+	_, ok := r.seen[id]
+	return ok
+}
+
+// gaps holds ids skipped by the cursor: a transaction can take an id and
+// commit after a later one. Polling rechecks them until they show up or
+// expire, since ids of rolled back transactions never do.
+type gaps map[int64]time.Time
+
+func (g gaps) track(from, to int64, now time.Time) {
+	for id := from + 1; id < to && len(g) < feedMaxGaps; id++ {
+		g[id] = now
+	}
+}
+
+func (g gaps) ids(now time.Time) []int64 {
+	ids := make([]int64, 0, len(g))
+	for id, seenAt := range g {
+		if now.Sub(seenAt) > feedGapTimeout {
+			delete(g, id)
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func (f *EventFeed) Run(ctx context.Context, deliver func(entity.Event)) {
+	connected := false
+	recent := &recentIds{seen: make(map[int64]struct{}, feedRecentIds)}
+	missing := make(gaps)
+
+	listener := pq.NewListener(f.connStr, feedMinReconnectDelay, feedMaxReconnectDelay, func(ev pq.ListenerEventType, err error) {
+		switch ev {
+		case pq.ListenerEventConnected:
+			f.logger.Info("event feed listener connected", "channel", EventsChannel)
+		case pq.ListenerEventDisconnected:
+			f.logger.Warn("event feed listener disconnected, polling the event table", "error", err)
+		case pq.ListenerEventReconnected:
+			f.logger.Info("event feed listener reconnected", "channel", EventsChannel)
+		case pq.ListenerEventConnectionAttemptFailed:
+			f.logger.Warn("event feed listener failed to connect", "error", err)
+		}
+	})
+	defer listener.Close()
+
+	// Listen blocks until the listener connects, so it runs in the background
+	// and the feed polls the event table until it succeeds.
+	listening := make(chan error, 1)
+	go func() {
+		listening <- listener.Listen(EventsChannel)
+	}()
+
+	cursor, err := f.lastEventId(ctx)
+	for err != nil {
+		f.logger.Error("failed to get event feed cursor", "error", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(f.pollInterval):
+		}
+		cursor, err = f.lastEventId(ctx)
+	}
+
+	f.logger.Info("event feed started", "channel", EventsChannel, "cursor", cursor, "poll_interval", f.pollInterval.String())
+
+	poll := time.NewTicker(f.pollInterval)
+	defer poll.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			f.logger.Info("event feed stopped")
+			return
+		case err := <-listening:
+			if err != nil {
+				f.logger.Warn("failed to listen for events, polling the event table", "channel", EventsChannel, "error", err)
+				continue
+			}
+			connected = true
+		case n := <-listener.Notify:
+			connected = true
+
+			if n != nil {
+				if id, err := strconv.ParseInt(n.Extra, 10, 64); err == nil && id <= cursor {
+					if !recent.has(id) && f.deliverLate(ctx, id, deliver) {
+						recent.add(id)
+						delete(missing, id)
+					}
+					continue
+				}
+			}
+		case <-poll.C:
+			if connected {
+				if err := listener.Ping(); err == nil {
+					continue
+				}
+				connected = false
+			}
+		}
+
+		f.recheckGaps(ctx, missing, recent, deliver)
+		cursor = f.catchUp(ctx, cursor, missing, recent, deliver)
+	}
+}
+
+func (f *EventFeed) catchUp(ctx context.Context, cursor int64, missing gaps, recent *recentIds, deliver func(entity.Event)) int64 {
+	for {
+		ids, events, err := f.eventsAfter(ctx, cursor)
+		if err != nil {
+			f.logger.Error("failed to read event feed", "cursor", cursor, "error", err)
+			return cursor
+		}
+
+		now := time.Now()
+
+		for i := range events {
+			missing.track(cursor, ids[i], now)
+
+			if !recent.has(ids[i]) {
+				deliver(events[i])
+				recent.add(ids[i])
+			}
+			cursor = ids[i]
+		}
+
+		if len(events) < feedBatchSize {
+			return cursor
+		}
+	}
+}
+
+func (f *EventFeed) recheckGaps(ctx context.Context, missing gaps, recent *recentIds, deliver func(entity.Event)) {
+	ids := missing.ids(time.Now())
+	if len(ids) == 0 {
+		return
+	}
+
+	found, events, err := f.eventsByIds(ctx, ids)
+	if err != nil {
+		f.logger.Error("failed to recheck event feed gaps", "gaps", len(ids), "error", err)
+		return
+	}
+
+	for i := range events {
+		delete(missing, found[i])
+
+		if !recent.has(found[i]) {
+			deliver(events[i])
+			recent.add(found[i])
+		}
+	}
+}
+
+func (f *EventFeed) deliverLate(ctx context.Context, id int64, deliver func(entity.Event)) bool {
+	query, args, err := f.sq.Select("event").From("event_outbox").Where(squirrel.Eq{"id": id}).ToSql()
+
+	if err != nil {
+		f.logger.Error("failed to build select feed event", "id", id, "error", err)
+		return false
+	}
+
+	var payload []byte
+	if err := f.db.QueryRowContext(ctx, query, args...).Scan(&payload); err != nil {
+		f.logger.Error("failed to read feed event", "id", id, "error", err)
+		return false
+	}
+
+	var event entity.Event
+	if err := json.Unmarshal(payload, &event); err != nil {
+		f.logger.Error("failed to unmarshal feed event", "id", id, "error", err)
+		return false
+	}
+
+	deliver(event)
+	return true
+}
+
+func (f *EventFeed) lastEventId(ctx context.Context) (int64, error) {
+	query, args, err := f.sq.Select("COALESCE(MAX(id), 0)").From("event_outbox").ToSql()
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to build select last event id: %w", err)
+	}
+
+	var id int64
+	if err := f.db.QueryRowContext(ctx, query, args...).Scan(&id); err != nil {
+		return 0, fmt.Errorf("exec select last event id: %w", err)
+	}
+
+	return id, nil
+}
+
+func (f *EventFeed) eventsAfter(ctx context.Context, cursor int64) ([]int64, []entity.Event, error) {
+	query, args, err := f.sq.Select("id", "event").From("event_outbox").Where(squirrel.Gt{"id": cursor}).
+		OrderBy("id").Limit(feedBatchSize).ToSql()
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build select feed events: %w", err)
+	}
+
+	return f.queryEvents(ctx, query, args)
+}
+
+func (f *EventFeed) eventsByIds(ctx context.Context, ids []int64) ([]int64, []entity.Event, error) {
+	query, args, err := f.sq.Select("id", "event").From("event_outbox").Where("id = ANY(?)", pq.Array(ids)).OrderBy("id").ToSql()
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build select feed events by id: %w", err)
+	}
+
+	return f.queryEvents(ctx, query, args)
+}
+
+func (f *EventFeed) queryEvents(ctx context.Context, query string, args []interface{}) ([]int64, []entity.Event, error) {
+	rows, err := f.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("exec select feed events: %w", err)
+	}
+	defer rows.Close()
+
+	ids := make([]int64, 0)
+	events := make([]entity.Event, 0)
+
+	for rows.Next() {
+		var id int64
+		var payload []byte
+		if err := rows.Scan(&id, &payload); err != nil {
+			return nil, nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		var event entity.Event
+		if err := json.Unmarshal(payload, &event); err != nil {
+			return nil, nil, fmt.Errorf("unmarshal event: %w", err)
+		}
+
+		ids = append(ids, id)
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return ids, events, nil
+}
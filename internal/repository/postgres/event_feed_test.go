@@ -0,0 +1,40 @@
+package postgres
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestGaps(t *testing.T) {
+	now := time.Now()
+	missing := make(gaps)
+
+	missing.track(10, 11, now)
+	missing.track(11, 14, now)
+	missing.track(14, 16, now.Add(-2*feedGapTimeout))
+
+	if got := len(missing); got != 3 {
+		t.Fatalf("tracked %d gaps, want 3", got)
+	}
+
+	ids := missing.ids(now)
+	slices.Sort(ids)
+
+	if !slices.Equal(ids, []int64{12, 13}) {
+		t.Errorf("ids() = %v, want [12 13]", ids)
+	}
+
+	if _, ok := missing[15]; ok {
+		t.Error("expired gap is still tracked")
+	}
+}
+
+func TestGapsLimit(t *testing.T) {
+	missing := make(gaps)
+	missing.track(0, 10*feedMaxGaps, time.Now())
+
+	if got := len(missing); got != feedMaxGaps {
+		t.Errorf("tracked %d gaps, want at most %d", got, feedMaxGaps)
+	}
+}
@@ -0,0 +1,171 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"pullrequest-service/internal/entity"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+)
+
+type PostgresNotificationRepository struct {
+	db *sql.DB
+	sq squirrel.StatementBuilderType
+}
+
+func NewPostgresNotificationRepository(db *sql.DB) *PostgresNotificationRepository {
+	return &PostgresNotificationRepository{db: db, sq: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)}
+}
+
+var notificationColumns = []string{"id", "user_id", "event_id", "kind", "pull_request_id", "text", "digest", "status", "attempts",
+	"next_attempt_at", "last_error", "sent_channels", "sent_at", "created_at"}
+
+type chatChannelRecord struct {
+	Kind    entity.ChatKind `json:"kind"`
+	Channel string          `json:"channel,omitempty"`
+}
+
+func (r *PostgresNotificationRepository) SetPreferences(ctx context.Context, prefs *entity.NotificationPreferences) error {
+	records := make([]chatChannelRecord, len(prefs.Channels))
+	for i, channel := range prefs.Channels {
+		records[i] = chatChannelRecord{Kind: channel.Kind, Channel: channel.Channel}
+	}
+
+	channels, err := json.Marshal(records)
+	if err != nil {
+		return fmt.Errorf("marshal channels: %w", err)
+	}
+
+	query, args, err := r.sq.Insert("notification_preferences").Columns("user_id", "channels", "mute", "digest_only").
+		Values(prefs.UserID, channels, prefs.Mute, prefs.DigestOnly).
+		Suffix("ON CONFLICT (user_id) DO UPDATE SET channels = EXCLUDED.channels, mute = EXCLUDED.mute, digest_only = EXCLUDED.digest_only").ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build upsert notification preferences: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	_, err = exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return fmt.Errorf("user for notification preferences: %w", entity.ErrNotFound)
+		}
+		return fmt.Errorf("exec upsert notification preferences: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresNotificationRepository) GetPreferences(ctx context.Context, userId string) (*entity.NotificationPreferences, error) {
+	query, args, err := r.sq.Select("channels", "mute", "digest_only").From("notification_preferences").
+		Where(squirrel.Eq{"user_id": userId}).ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select notification preferences: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	prefs := &entity.NotificationPreferences{UserID: userId}
+	var channels []byte
+	if err := exec.QueryRowContext(ctx, query, args...).Scan(&channels, &prefs.Mute, &prefs.DigestOnly); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("notification preferences: %w", entity.ErrNotFound)
+		}
+		return nil, fmt.Errorf("exec select notification preferences: %w", err)
+	}
+
+	var records []chatChannelRecord
+	if err := json.Unmarshal(channels, &records); err != nil {
+		return nil, fmt.Errorf("unmarshal channels: %w", err)
+	}
+
+	for _, record := range records {
+		prefs.Channels = append(prefs.Channels, entity.ChatChannel{Kind: record.Kind, Channel: record.Channel})
+	}
+
+	return prefs, nil
+}
+
+func (r *PostgresNotificationRepository) CreateNotification(ctx context.Context, n *entity.Notification) error {
+	query, args, err := r.sq.Insert("notifications").Columns("user_id", "event_id", "kind", "pull_request_id", "text", "digest").
+		Values(n.UserID, n.EventID, n.Kind, n.PullRequestID, n.Text, n.Digest).
+		Suffix("ON CONFLICT (event_id, user_id) DO NOTHING").ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build insert notification: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	_, err = exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return fmt.Errorf("user for notification: %w", entity.ErrNotFound)
+		}
+		return fmt.Errorf("exec insert notification: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresNotificationRepository) ClaimDueNotifications(ctx context.Context, digest bool, now time.Time, lease time.Duration, limit uint64) ([]entity.Notification, error) {
+	query, args, err := r.sq.Update("notifications").Set("next_attempt_at", now.Add(lease)).
+		Where(`id IN (SELECT id FROM notifications WHERE status = ? AND digest = ? AND next_attempt_at <= ?
+			ORDER BY next_attempt_at, id LIMIT ? FOR UPDATE SKIP LOCKED)`, entity.DeliveryPending, digest, now, limit).
+		Suffix("RETURNING " + strings.Join(notificationColumns, ", ")).ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build claim notifications: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec claim notifications: %w", err)
+	}
+	defer rows.Close()
+
+	notifications := make([]entity.Notification, 0)
+	for rows.Next() {
+		var n entity.Notification
+		if err := rows.Scan(&n.ID, &n.UserID, &n.EventID, &n.Kind, &n.PullRequestID, &n.Text, &n.Digest, &n.Status, &n.Attempts,
+			&n.NextAttemptAt, &n.LastError, pq.Array(&n.SentChannels), &n.SentAt, &n.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan notification row: %w", err)
+		}
+		notifications = append(notifications, n)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return notifications, nil
+}
+
+func (r *PostgresNotificationRepository) UpdateNotification(ctx context.Context, n *entity.Notification) error {
+	query, args, err := r.sq.Update("notifications").Set("status", n.Status).Set("attempts", n.Attempts).
+		Set("next_attempt_at", n.NextAttemptAt).Set("last_error", n.LastError).Set("sent_channels", stringArray(n.SentChannels)).
+		Set("sent_at", n.SentAt).
+		Where(squirrel.Eq{"id": n.ID}).ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build update notification: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	_, err = exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec update notification: %w", err)
+	}
+
+	return nil
+}
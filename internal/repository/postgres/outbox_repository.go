@@ -0,0 +1,278 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"pullrequest-service/internal/entity"
+	"strconv"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+)
+
+const (
+	outboxRelayLockKey = 4_307_001
+	EventsChannel      = "pr_events"
+)
+
+type PostgresOutboxRepository struct {
+	db *sql.DB
+	sq squirrel.StatementBuilderType
+}
+
+func NewPostgresOutboxRepository(db *sql.DB) *PostgresOutboxRepository {
+	return &PostgresOutboxRepository{db: db, sq: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)}
+}
+
+func (r *PostgresOutboxRepository) AddEvent(ctx context.Context, event entity.Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal event: %w", err)
+	}
+
+	query, args, err := r.sq.Insert("event_outbox").Columns("event_id", "event_type", "ordering_key", "event", "created_at").
+		Values(event.ID, event.Type, event.OrderingKey(), payload, event.OccurredAt).Suffix("RETURNING id").ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build insert outbox event: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	var id int64
+	if err := exec.QueryRowContext(ctx, query, args...).Scan(&id); err != nil {
+		return fmt.Errorf("exec insert outbox event: %w", err)
+	}
+
+	query, args, err = r.sq.Select().Column(squirrel.Expr("pg_notify(?, ?)", EventsChannel, strconv.FormatInt(id, 10))).ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build notify outbox event: %w", err)
+	}
+
+	if _, err := exec.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("exec notify outbox event: %w", err)
+	}
+
+	return nil
+}
+
+// TryLockRelay takes the relay lock on a connection of its own, so the lock is
+// held while sinks call out to other services without keeping a transaction
+// open. The returned function releases it.
+func (r *PostgresOutboxRepository) TryLockRelay(ctx context.Context) (func(), bool, error) {
+	query, args, err := r.sq.Select().Column(squirrel.Expr("pg_try_advisory_lock(?)", outboxRelayLockKey)).ToSql()
+
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to build outbox relay lock: %w", err)
+	}
+
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("get outbox relay connection: %w", err)
+	}
+
+	var locked bool
+	if err := conn.QueryRowContext(ctx, query, args...).Scan(&locked); err != nil {
+		conn.Close()
+		return nil, false, fmt.Errorf("exec outbox relay lock: %w", err)
+	}
+
+	if !locked {
+		conn.Close()
+		return nil, false, nil
+	}
+
+	unlock := func() {
+		query, args, err := r.sq.Select().Column(squirrel.Expr("pg_advisory_unlock(?)", outboxRelayLockKey)).ToSql()
+		if err == nil {
+			_, err = conn.ExecContext(context.Background(), query, args...)
+		}
+
+		if err != nil {
+			// A session lock outlives the relay if the connection goes back to
+			// the pool, so a connection that failed to unlock is discarded.
+			conn.Raw(func(any) error { return driver.ErrBadConn })
+		}
+
+		conn.Close()
+	}
+
+	return unlock, true, nil
+}
+
+// GetPendingEvents returns due events that head their ordering key: events
+// queued behind an undelivered one for the same key are left out, so a stuck
+// key cannot fill the page and starve the others.
+func (r *PostgresOutboxRepository) GetPendingEvents(ctx context.Context, now time.Time, limit uint64) ([]entity.OutboxEvent, error) {
+	query, args, err := r.sq.Select("o.id", "o.event", "o.attempts", "o.next_attempt_at", "o.last_error", "o.delivered_sinks", "o.created_at",
+		"o.processed_at", "o.dead_at").
+		From("event_outbox o").
+		Where(squirrel.Eq{"o.processed_at": nil, "o.dead_at": nil}).
+		Where(squirrel.LtOrEq{"o.next_attempt_at": now}).
+		Where("NOT EXISTS (SELECT 1 FROM event_outbox b WHERE b.ordering_key = o.ordering_key AND b.id < o.id AND b.processed_at IS NULL AND b.dead_at IS NULL)").
+		OrderBy("o.id").Limit(limit).ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select pending outbox events: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec select pending outbox events: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]entity.OutboxEvent, 0)
+
+	for rows.Next() {
+		var event entity.OutboxEvent
+		var payload []byte
+		if err := rows.Scan(&event.ID, &payload, &event.Attempts, &event.NextAttemptAt, &event.LastError, pq.Array(&event.DeliveredSinks),
+			&event.CreatedAt, &event.ProcessedAt, &event.DeadAt); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		if err := json.Unmarshal(payload, &event.Event); err != nil {
+			return nil, fmt.Errorf("unmarshal event: %w", err)
+		}
+
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return events, nil
+}
+
+func (r *PostgresOutboxRepository) MarkSinkDelivered(ctx context.Context, id int64, sink string) error {
+	query, args, err := r.sq.Update("event_outbox").Set("delivered_sinks", squirrel.Expr("array_append(delivered_sinks, ?)", sink)).
+		Where(squirrel.Eq{"id": id}).ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build mark outbox sink delivered: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	_, err = exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec mark outbox sink delivered: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresOutboxRepository) MarkEventProcessed(ctx context.Context, id int64, at time.Time) error {
+	query, args, err := r.sq.Update("event_outbox").Set("processed_at", at).Set("last_error", "").
+		Where(squirrel.Eq{"id": id}).ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build mark outbox event processed: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	_, err = exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec mark outbox event processed: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresOutboxRepository) MarkEventFailed(ctx context.Context, id int64, lastError string, nextAttemptAt time.Time) error {
+	query, args, err := r.sq.Update("event_outbox").Set("attempts", squirrel.Expr("attempts + 1")).Set("last_error", lastError).
+		Set("next_attempt_at", nextAttemptAt).Where(squirrel.Eq{"id": id}).ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build mark outbox event failed: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	_, err = exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec mark outbox event failed: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresOutboxRepository) MarkEventDead(ctx context.Context, id int64, lastError string, at time.Time) error {
+	query, args, err := r.sq.Update("event_outbox").Set("attempts", squirrel.Expr("attempts + 1")).Set("last_error", lastError).
+		Set("dead_at", at).Where(squirrel.Eq{"id": id}).ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build mark outbox event dead: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	_, err = exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec mark outbox event dead: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresOutboxRepository) GetEventsAfter(ctx context.Context, eventId string, limit uint64) ([]entity.Event, error) {
+	query, args, err := r.sq.Select("id").From("event_outbox").Where(squirrel.Eq{"event_id": eventId}).ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select outbox event id: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	var afterId int64
+	if err := exec.QueryRowContext(ctx, query, args...).Scan(&afterId); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("outbox event: %w", entity.ErrNotFound)
+		}
+		return nil, fmt.Errorf("exec select outbox event id: %w", err)
+	}
+
+	query, args, err = r.sq.Select("event").From("event_outbox").Where(squirrel.Gt{"id": afterId}).OrderBy("id").Limit(limit).ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select outbox events: %w", err)
+	}
+
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec select outbox events: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]entity.Event, 0)
+
+	for rows.Next() {
+		var event entity.Event
+		var payload []byte
+		if err := rows.Scan(&payload); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		if err := json.Unmarshal(payload, &event); err != nil {
+			return nil, fmt.Errorf("unmarshal event: %w", err)
+		}
+
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return events, nil
+}
@@ -3,10 +3,13 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"pullrequest-service/internal/entity"
+	"time"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
 )
 
 type PostgresPRRepository struct {
@@ -52,9 +55,9 @@ func (r *PostgresPRRepository) GetAllPRForReviewer(ctx context.Context, userId s
 	return prList, nil
 }
 
-func (r *PostgresPRRepository) CreatePR(ctx context.Context, pr *entity.PullRequestShort) error {
-	query, args, err := r.sq.Insert("pull_requests").Columns("pull_request_id", "pull_request_name", "author_id", "status").
-		Values(pr.PullRequestID, pr.PullRequestName, pr.AuthorID, pr.Status).ToSql()
+func (r *PostgresPRRepository) CreatePR(ctx context.Context, pr *entity.PullRequest) error {
+	query, args, err := r.sq.Insert("pull_requests").Columns("pull_request_id", "pull_request_name", "author_id", "status", "tags").
+		Values(pr.PullRequestID, pr.PullRequestName, pr.AuthorID, pr.Status, stringArray(pr.Tags)).ToSql()
 
 	if err != nil {
 		return fmt.Errorf("failed to build insert PR: %w", err)
@@ -82,6 +85,9 @@ func (r *PostgresPRRepository) AddReviewerForPR(ctx context.Context, prId string
 
 	_, err = exec.ExecContext(ctx, query, args...)
 	if err != nil {
+		if isForeignKeyViolation(err) {
+			return fmt.Errorf("reviewer for PR: %w", entity.ErrNotFound)
+		}
 		return fmt.Errorf("exec insert pr_reviewer: %w", err)
 	}
 
@@ -107,6 +113,23 @@ func (r *PostgresPRRepository) MergePR(ctx context.Context, prId string) error {
 
 }
 
+func (r *PostgresPRRepository) SetPRStatus(ctx context.Context, prId string, status entity.Status) error {
+	query, args, err := r.sq.Update("pull_requests").Set("status", status).Where(squirrel.Eq{"pull_request_id": prId}).ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build update PR status: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	_, err = exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec update PR status: %w", err)
+	}
+
+	return nil
+}
+
 func (r *PostgresPRRepository) IsReviewerForPR(ctx context.Context, prId string, userId string) (bool, error) {
 	query, args, err := r.sq.Select("1").From("pull_requests pr").
 		Join("pr_reviewers prr ON pr.pull_request_id = prr.pull_request_id").
@@ -167,7 +190,7 @@ func (r *PostgresPRRepository) DeleteReviewer(ctx context.Context, prId string,
 }
 
 func (r PostgresPRRepository) GetPRById(ctx context.Context, prId string) (*entity.PullRequest, error) {
-	query, args, err := r.sq.Select("pull_request_id", "pull_request_name", "author_id", "status", "created_at", "merged_at").
+	query, args, err := r.sq.Select("pull_request_id", "pull_request_name", "author_id", "status", "created_at", "merged_at", "tags").
 		From("pull_requests").Where(squirrel.Eq{"pull_request_id": prId}).ToSql()
 
 	if err != nil {
@@ -177,7 +200,7 @@ func (r PostgresPRRepository) GetPRById(ctx context.Context, prId string) (*enti
 	exec := executerFromContext(ctx, r.db)
 
 	var PR entity.PullRequest
-	if err := exec.QueryRowContext(ctx, query, args...).Scan(&PR.PullRequestID, &PR.PullRequestName, &PR.AuthorID, &PR.Status, &PR.CreatedAt, &PR.MergedAt); err != nil {
+	if err := exec.QueryRowContext(ctx, query, args...).Scan(&PR.PullRequestID, &PR.PullRequestName, &PR.AuthorID, &PR.Status, &PR.CreatedAt, &PR.MergedAt, pq.Array(&PR.Tags)); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, fmt.Errorf("PR not found: %w", entity.ErrNotFound)
 		}
@@ -241,3 +264,433 @@ func (r *PostgresPRRepository) IsPRExist(ctx context.Context, prId string) (bool
 
 	return true, nil
 }
+
+func (r *PostgresPRRepository) GetAllPRs(ctx context.Context) ([]entity.PullRequest, error) {
+	query, args, err := r.sq.Select("pull_request_id", "pull_request_name", "author_id", "status", "created_at", "merged_at", "tags").
+		From("pull_requests").OrderBy("created_at", "pull_request_id").ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select all PR: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to exec select all PR: %w", err)
+	}
+	defer rows.Close()
+
+	prList := make([]entity.PullRequest, 0)
+	for rows.Next() {
+		var pr entity.PullRequest
+
+		if err := rows.Scan(&pr.PullRequestID, &pr.PullRequestName, &pr.AuthorID, &pr.Status, &pr.CreatedAt, &pr.MergedAt, pq.Array(&pr.Tags)); err != nil {
+			return nil, fmt.Errorf("failed to scan: %w", err)
+		}
+		pr.AssignedReviewers = make([]string, 0)
+		prList = append(prList, pr)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	reviewers, err := r.getAllReviewers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range prList {
+		if ids, ok := reviewers[prList[i].PullRequestID]; ok {
+			prList[i].AssignedReviewers = ids
+		}
+	}
+
+	return prList, nil
+}
+
+func (r *PostgresPRRepository) getAllReviewers(ctx context.Context) (map[string][]string, error) {
+	query, args, err := r.sq.Select("pull_request_id", "user_id").From("pr_reviewers").
+		OrderBy("pull_request_id", "user_id").ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select all reviewers: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to exec select all reviewers: %w", err)
+	}
+	defer rows.Close()
+
+	reviewers := make(map[string][]string)
+	for rows.Next() {
+		var prId, userId string
+
+		if err := rows.Scan(&prId, &userId); err != nil {
+			return nil, fmt.Errorf("failed to scan: %w", err)
+		}
+		reviewers[prId] = append(reviewers[prId], userId)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return reviewers, nil
+}
+
+func (r *PostgresPRRepository) InsertPR(ctx context.Context, pr *entity.PullRequest) error {
+	builder := r.sq.Insert("pull_requests").Columns("pull_request_id", "pull_request_name", "author_id", "status", "created_at", "merged_at", "tags")
+
+	if pr.CreatedAt != nil {
+		builder = builder.Values(pr.PullRequestID, pr.PullRequestName, pr.AuthorID, pr.Status, *pr.CreatedAt, pr.MergedAt, stringArray(pr.Tags))
+	} else {
+		builder = builder.Values(pr.PullRequestID, pr.PullRequestName, pr.AuthorID, pr.Status, squirrel.Expr("NOW()"), pr.MergedAt, stringArray(pr.Tags))
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert full PR: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	_, err = exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return fmt.Errorf("author for PR: %w", entity.ErrNotFound)
+		}
+		return fmt.Errorf("exec insert full PR: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresPRRepository) UpdatePR(ctx context.Context, pr *entity.PullRequest) error {
+	builder := r.sq.Update("pull_requests").Set("pull_request_name", pr.PullRequestName).Set("author_id", pr.AuthorID).
+		Set("status", pr.Status).Set("merged_at", pr.MergedAt).Set("tags", stringArray(pr.Tags)).
+		Where(squirrel.Eq{"pull_request_id": pr.PullRequestID})
+
+	if pr.CreatedAt != nil {
+		builder = builder.Set("created_at", *pr.CreatedAt)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update PR: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	_, err = exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return fmt.Errorf("author for PR: %w", entity.ErrNotFound)
+		}
+		return fmt.Errorf("exec update PR: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresPRRepository) DeleteAllReviewers(ctx context.Context, prId string) error {
+	query, args, err := r.sq.Delete("pr_reviewers").Where(squirrel.Eq{"pull_request_id": prId}).ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build delete all reviewers: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	_, err = exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to exec delete all reviewers: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresPRRepository) GetRecentReviewerCounts(ctx context.Context, authorId string, window int) (map[string]int, error) {
+	query, args, err := r.sq.Select("user_id", "COUNT(*)").From("pr_reviewers").
+		Where(`pull_request_id IN (SELECT pull_request_id FROM pull_requests
+			WHERE author_id = ? ORDER BY created_at DESC, pull_request_id DESC LIMIT ?)`, authorId, window).
+		GroupBy("user_id").ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build get recent reviewers: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec select recent reviewers: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+
+	for rows.Next() {
+		var userId string
+		var count int
+		if err := rows.Scan(&userId, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		counts[userId] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return counts, nil
+}
+
+func (r *PostgresPRRepository) AddAssignmentRecord(ctx context.Context, record *entity.AssignmentRecord) error {
+	explanation, err := json.Marshal(record.Explanation)
+	if err != nil {
+		return fmt.Errorf("marshal explanation: %w", err)
+	}
+
+	var oldReviewerId, reason *string
+	if record.OldReviewerID != "" {
+		oldReviewerId = &record.OldReviewerID
+	}
+	if record.Reason != "" {
+		reason = &record.Reason
+	}
+
+	query, args, err := r.sq.Insert("reviewer_assignments").
+		Columns("pull_request_id", "action", "reviewer_ids", "old_reviewer_id", "reason", "explanation").
+		Values(record.PullRequestID, record.Action, stringArray(record.ReviewerIDs), oldReviewerId, reason, explanation).ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build insert assignment record: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	_, err = exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec insert assignment record: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresPRRepository) GetAssignmentHistory(ctx context.Context, prId string) ([]entity.AssignmentRecord, error) {
+	query, args, err := r.sq.Select("id", "pull_request_id", "action", "reviewer_ids", "COALESCE(old_reviewer_id, '')", "COALESCE(reason, '')",
+		"explanation", "created_at").
+		From("reviewer_assignments").Where(squirrel.Eq{"pull_request_id": prId}).OrderBy("created_at", "id").ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select assignment history: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec select assignment history: %w", err)
+	}
+	defer rows.Close()
+
+	history := make([]entity.AssignmentRecord, 0)
+
+	for rows.Next() {
+		var record entity.AssignmentRecord
+		var explanation []byte
+		if err := rows.Scan(&record.ID, &record.PullRequestID, &record.Action, pq.Array(&record.ReviewerIDs),
+			&record.OldReviewerID, &record.Reason, &explanation, &record.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		if err := json.Unmarshal(explanation, &record.Explanation); err != nil {
+			return nil, fmt.Errorf("unmarshal explanation: %w", err)
+		}
+
+		history = append(history, record)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return history, nil
+}
+
+func (r *PostgresPRRepository) GetReviewerStats(ctx context.Context, userId string) (*entity.ReviewerStats, error) {
+	query, args, err := r.sq.Select().
+		Column(`(SELECT COUNT(*) FROM pr_reviewers prr
+			JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+			WHERE prr.user_id = ? AND pr.status = ?)`, userId, entity.OPEN).
+		Column("(SELECT COUNT(*) FROM pr_reviewers WHERE user_id = ?)", userId).
+		Column("(SELECT COUNT(*) FROM reviewer_assignments WHERE old_reviewer_id = ? AND action = ?)", userId, entity.AssignmentDeclined).
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select reviewer stats: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	stats := &entity.ReviewerStats{UserID: userId}
+	if err := exec.QueryRowContext(ctx, query, args...).Scan(&stats.OpenReviews, &stats.TotalReviews, &stats.Declined); err != nil {
+		return nil, fmt.Errorf("exec select reviewer stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+func (r *PostgresPRRepository) GetPendingSLAReviews(ctx context.Context) ([]entity.SLAReview, error) {
+	query, args, err := r.sq.Select("prr.pull_request_id", "prr.user_id", "prr.assigned_at", "t.review_sla_hours", "t.sla_action",
+		"rv.timezone", "rv.work_start", "rv.work_end").From("pr_reviewers prr").
+		Join("pull_requests pr ON pr.pull_request_id = prr.pull_request_id").
+		Join("users a ON a.user_id = pr.author_id").
+		Join("teams t ON t.team_name = a.team_name").
+		Join("users rv ON rv.user_id = prr.user_id").
+		Where(squirrel.Eq{"pr.status": entity.OPEN, "prr.responded_at": nil, "prr.overdue_at": nil}).
+		Where("t.review_sla_hours > 0").
+		OrderBy("prr.assigned_at").ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select pending SLA reviews: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec select pending SLA reviews: %w", err)
+	}
+	defer rows.Close()
+
+	reviews := make([]entity.SLAReview, 0)
+
+	for rows.Next() {
+		var review entity.SLAReview
+		if err := rows.Scan(&review.PullRequestID, &review.ReviewerID, &review.AssignedAt, &review.SLAHours, &review.Action,
+			&review.WorkingHours.Timezone, &review.WorkingHours.Start, &review.WorkingHours.End); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		reviews = append(reviews, review)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return reviews, nil
+}
+
+func (r *PostgresPRRepository) MarkReviewOverdue(ctx context.Context, prId, userId string, at time.Time) error {
+	query, args, err := r.sq.Update("pr_reviewers").Set("overdue_at", at).
+		Where(squirrel.Eq{"pull_request_id": prId, "user_id": userId}).ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build mark review overdue: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	_, err = exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec mark review overdue: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresPRRepository) MarkReviewResponded(ctx context.Context, prId, userId string, at time.Time) error {
+	query, args, err := r.sq.Update("pr_reviewers").Set("responded_at", at).
+		Where(squirrel.Eq{"pull_request_id": prId, "user_id": userId, "responded_at": nil}).ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build mark review responded: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	_, err = exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec mark review responded: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresPRRepository) GetReviewStates(ctx context.Context, prId string) ([]entity.ReviewState, error) {
+	query, args, err := r.sq.Select("prr.user_id", "prr.assigned_at", "prr.responded_at", "prr.overdue_at", "u.timezone", "u.work_start", "u.work_end").
+		From("pr_reviewers prr").Join("users u ON u.user_id = prr.user_id").
+		Where(squirrel.Eq{"prr.pull_request_id": prId}).OrderBy("prr.user_id").ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select review states: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec select review states: %w", err)
+	}
+	defer rows.Close()
+
+	states := make([]entity.ReviewState, 0)
+
+	for rows.Next() {
+		var state entity.ReviewState
+		if err := rows.Scan(&state.ReviewerID, &state.AssignedAt, &state.RespondedAt, &state.OverdueAt,
+			&state.WorkingHours.Timezone, &state.WorkingHours.Start, &state.WorkingHours.End); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		states = append(states, state)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return states, nil
+}
+
+func (r *PostgresPRRepository) GetOverduePRs(ctx context.Context) ([]entity.PullRequest, error) {
+	query, args, err := r.sq.Select("pr.pull_request_id", "pr.pull_request_name", "pr.author_id", "pr.status", "pr.created_at", "pr.merged_at", "pr.tags").
+		From("pull_requests pr").
+		Where(squirrel.Eq{"pr.status": entity.OPEN}).
+		Where(`EXISTS (SELECT 1 FROM pr_reviewers prr WHERE prr.pull_request_id = pr.pull_request_id
+			AND prr.overdue_at IS NOT NULL AND prr.responded_at IS NULL)`).
+		OrderBy("pr.created_at", "pr.pull_request_id").ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select overdue PRs: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec select overdue PRs: %w", err)
+	}
+	defer rows.Close()
+
+	prs := make([]entity.PullRequest, 0)
+
+	for rows.Next() {
+		var pr entity.PullRequest
+		if err := rows.Scan(&pr.PullRequestID, &pr.PullRequestName, &pr.AuthorID, &pr.Status, &pr.CreatedAt, &pr.MergedAt, pq.Array(&pr.Tags)); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		prs = append(prs, pr)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return prs, nil
+}
@@ -7,6 +7,7 @@ import (
 	"pullrequest-service/internal/entity"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
 )
 
 type PostgresTeamRepository struct {
@@ -38,6 +39,26 @@ func (r *PostgresTeamRepository) CreateNewTeam(ctx context.Context, teamName str
 	return nil
 }
 
+func (r *PostgresTeamRepository) IsTeamExist(ctx context.Context, teamName string) (bool, error) {
+	query, args, err := r.sq.Select("1").From("teams").Where(squirrel.Eq{"team_name": teamName}).ToSql()
+	if err != nil {
+		return false, fmt.Errorf("build select team exists query: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	var dummy int
+	err = exec.QueryRowContext(ctx, query, args...).Scan(&dummy)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, fmt.Errorf("exec select team exists query: %w", err)
+	}
+
+	return true, nil
+}
+
 func (r *PostgresTeamRepository) GetTeamNameByUserId(ctx context.Context, userId string) (*string, error) {
 	var currentTeam string
 	query, args, err := r.sq.Select("team_name").From("users").Where(squirrel.Eq{"user_id": userId}).ToSql()
@@ -59,7 +80,7 @@ func (r *PostgresTeamRepository) GetTeamNameByUserId(ctx context.Context, userId
 }
 
 func (r *PostgresTeamRepository) GetTeamByName(ctx context.Context, teamName string) (*entity.Team, error) {
-	query, args, err := r.sq.Select("user_id", "username", "is_active").From("users").Where(squirrel.Eq{"team_name": teamName}).ToSql()
+	query, args, err := r.sq.Select("user_id", "username", "is_active", "tags", "seniority").From("users").Where(squirrel.Eq{"team_name": teamName}).ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build get team members query")
 	}
@@ -76,7 +97,7 @@ func (r *PostgresTeamRepository) GetTeamByName(ctx context.Context, teamName str
 
 	for rows.Next() {
 		var member entity.TeamMember
-		if err := rows.Scan(&member.UserID, &member.UserName, &member.IsActive); err != nil {
+		if err := rows.Scan(&member.UserID, &member.UserName, &member.IsActive, pq.Array(&member.Tags), &member.Seniority); err != nil {
 			return nil, fmt.Errorf("scan user row: %w", err)
 		}
 		members = append(members, member)
@@ -93,3 +114,172 @@ func (r *PostgresTeamRepository) GetTeamByName(ctx context.Context, teamName str
 	return team, nil
 
 }
+
+func (r *PostgresTeamRepository) GetAllTeams(ctx context.Context) ([]entity.Team, error) {
+	query, args, err := r.sq.Select("team_name", "default_max_open_reviews").From("teams").OrderBy("team_name").ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build select teams query: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec select teams: %w", err)
+	}
+	defer rows.Close()
+
+	teams := make([]entity.Team, 0)
+	for rows.Next() {
+		var team entity.Team
+		if err := rows.Scan(&team.TeamName, &team.DefaultMaxOpenReviews); err != nil {
+			return nil, fmt.Errorf("scan team row: %w", err)
+		}
+		teams = append(teams, team)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return teams, nil
+}
+
+func (r *PostgresTeamRepository) SetDefaultMaxOpenReviews(ctx context.Context, teamName string, maxOpenReviews *int) error {
+	query, args, err := r.sq.Update("teams").Set("default_max_open_reviews", maxOpenReviews).
+		Where(squirrel.Eq{"team_name": teamName}).ToSql()
+	if err != nil {
+		return fmt.Errorf("build update team default_max_open_reviews query: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	res, err := exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec update team default_max_open_reviews: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected: %w", err)
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("team: %w", entity.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *PostgresTeamRepository) GetFallbackTeams(ctx context.Context, teamName string) ([]string, error) {
+	query, args, err := r.sq.Select("fallback_team_name").From("team_fallbacks").
+		Where(squirrel.Eq{"team_name": teamName}).OrderBy("position").ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build select fallback teams query: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec select fallback teams: %w", err)
+	}
+	defer rows.Close()
+
+	teams := make([]string, 0)
+	for rows.Next() {
+		var fallbackTeam string
+		if err := rows.Scan(&fallbackTeam); err != nil {
+			return nil, fmt.Errorf("scan fallback team row: %w", err)
+		}
+		teams = append(teams, fallbackTeam)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return teams, nil
+}
+
+func (r *PostgresTeamRepository) SetFallbackTeams(ctx context.Context, teamName string, fallbackTeams []string) error {
+	deleteQuery, deleteArgs, err := r.sq.Delete("team_fallbacks").Where(squirrel.Eq{"team_name": teamName}).ToSql()
+	if err != nil {
+		return fmt.Errorf("build delete fallback teams query: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	if _, err := exec.ExecContext(ctx, deleteQuery, deleteArgs...); err != nil {
+		return fmt.Errorf("exec delete fallback teams: %w", err)
+	}
+
+	if len(fallbackTeams) == 0 {
+		return nil
+	}
+
+	insert := r.sq.Insert("team_fallbacks").Columns("team_name", "fallback_team_name", "position")
+	for i, fallbackTeam := range fallbackTeams {
+		insert = insert.Values(teamName, fallbackTeam, i)
+	}
+
+	query, args, err := insert.ToSql()
+	if err != nil {
+		return fmt.Errorf("build insert fallback teams query: %w", err)
+	}
+
+	if _, err := exec.ExecContext(ctx, query, args...); err != nil {
+		if isForeignKeyViolation(err) {
+			return fmt.Errorf("fallback team: %w", entity.ErrNotFound)
+		}
+		return fmt.Errorf("exec insert fallback teams: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresTeamRepository) GetTeamPolicy(ctx context.Context, teamName string) (*entity.TeamPolicy, error) {
+	query, args, err := r.sq.Select("mentorship_required", "repeat_pairing_window", "review_sla_hours", "sla_action").From("teams").Where(squirrel.Eq{"team_name": teamName}).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build select team policy query: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	var policy entity.TeamPolicy
+	if err := exec.QueryRowContext(ctx, query, args...).Scan(&policy.MentorshipRequired, &policy.RepeatPairingWindow, &policy.ReviewSLAHours, &policy.SLAAction); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("team: %w", entity.ErrNotFound)
+		}
+		return nil, fmt.Errorf("exec select team policy: %w", err)
+	}
+
+	return &policy, nil
+}
+
+func (r *PostgresTeamRepository) SetTeamPolicy(ctx context.Context, teamName string, policy *entity.TeamPolicy) error {
+	query, args, err := r.sq.Update("teams").Set("mentorship_required", policy.MentorshipRequired).
+		Set("repeat_pairing_window", policy.RepeatPairingWindow).Set("review_sla_hours", policy.ReviewSLAHours).
+		Set("sla_action", policy.SLAAction.OrDefault()).Where(squirrel.Eq{"team_name": teamName}).ToSql()
+	if err != nil {
+		return fmt.Errorf("build update team policy query: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	res, err := exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec update team policy: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected: %w", err)
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("team: %w", entity.ErrNotFound)
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"pullrequest-service/internal/entity"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
 )
 
 type PostgresUserRepository struct {
@@ -20,7 +21,11 @@ func NewPostgresUserRepository(db *sql.DB) *PostgresUserRepository {
 }
 
 func (r *PostgresUserRepository) AddUserToTeam(ctx context.Context, user *entity.User) error {
-	query, args, err := r.sq.Insert("users").Columns("user_id", "username", "is_active", "team_name").Values(user.UserID, user.UserName, user.IsActive, user.TeamName).ToSql()
+	hours := user.WorkingHours.OrDefault()
+	query, args, err := r.sq.Insert("users").Columns("user_id", "username", "is_active", "team_name", "max_open_reviews", "tags", "seniority", "timezone", "work_start", "work_end",
+		"email").
+		Values(user.UserID, user.UserName, user.IsActive, user.TeamName, user.MaxOpenReviews, stringArray(user.Tags), user.Seniority.OrDefault(), hours.Timezone, hours.Start, hours.End,
+			user.Email).ToSql()
 	if err != nil {
 		return fmt.Errorf("failed to build insert user query")
 	}
@@ -28,6 +33,9 @@ func (r *PostgresUserRepository) AddUserToTeam(ctx context.Context, user *entity
 	exec := executerFromContext(ctx, r.db)
 	_, err = exec.ExecContext(ctx, query, args...)
 	if err != nil {
+		if isForeignKeyViolation(err) {
+			return fmt.Errorf("team for user: %w", entity.ErrNotFound)
+		}
 		return fmt.Errorf("exec insert user: %w", err)
 	}
 
@@ -129,7 +137,8 @@ func (r *PostgresUserRepository) IsUserActive(ctx context.Context, userId string
 }
 
 func (r *PostgresUserRepository) GetUserById(ctx context.Context, userId string) (*entity.User, error) {
-	query, args, err := r.sq.Select("user_id", "username", "team_name", "is_active").From("users").Where(squirrel.Eq{"user_id": userId}).ToSql()
+	query, args, err := r.sq.Select("user_id", "username", "team_name", "is_active", "max_open_reviews", "tags", "seniority", "timezone", "work_start", "work_end", "email").From("users").
+		Where(squirrel.Eq{"user_id": userId}).ToSql()
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to build get user: %w", err)
@@ -139,7 +148,8 @@ func (r *PostgresUserRepository) GetUserById(ctx context.Context, userId string)
 
 	user := &entity.User{}
 
-	if err := exec.QueryRowContext(ctx, query, args...).Scan(&user.UserID, &user.UserName, &user.TeamName, &user.IsActive); err != nil {
+	if err := exec.QueryRowContext(ctx, query, args...).Scan(&user.UserID, &user.UserName, &user.TeamName, &user.IsActive, &user.MaxOpenReviews, pq.Array(&user.Tags), &user.Seniority,
+		&user.WorkingHours.Timezone, &user.WorkingHours.Start, &user.WorkingHours.End, &user.Email); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, entity.ErrNotFound
 		}
@@ -149,3 +159,315 @@ func (r *PostgresUserRepository) GetUserById(ctx context.Context, userId string)
 	return user, nil
 
 }
+
+func (r *PostgresUserRepository) SetUsername(ctx context.Context, userId string, username string) error {
+	query, args, err := r.sq.Update("users").Set("username", username).Where(squirrel.Eq{"user_id": userId}).ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build set username: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	_, err = exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec update username: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresUserRepository) ListUsers(ctx context.Context, filter *entity.UserFilter) ([]entity.User, error) {
+	builder := r.sq.Select("user_id", "username", "team_name", "is_active", "max_open_reviews", "tags", "seniority", "timezone", "work_start", "work_end", "email").From("users").
+		OrderBy("team_name", "user_id").Offset(filter.Offset)
+
+	if filter.Limit > 0 {
+		builder = builder.Limit(filter.Limit)
+	}
+
+	if filter.TeamName != nil {
+		builder = builder.Where(squirrel.Eq{"team_name": *filter.TeamName})
+	}
+
+	if filter.IsActive != nil {
+		builder = builder.Where(squirrel.Eq{"is_active": *filter.IsActive})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build list users: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec list users: %w", err)
+	}
+	defer rows.Close()
+
+	users := make([]entity.User, 0)
+
+	for rows.Next() {
+		var user entity.User
+		if err := rows.Scan(&user.UserID, &user.UserName, &user.TeamName, &user.IsActive, &user.MaxOpenReviews, pq.Array(&user.Tags), &user.Seniority,
+			&user.WorkingHours.Timezone, &user.WorkingHours.Start, &user.WorkingHours.End, &user.Email); err != nil {
+			return nil, fmt.Errorf("failed to scan user row: %w", err)
+		}
+		users = append(users, user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return users, nil
+}
+
+func (r *PostgresUserRepository) UpdateUser(ctx context.Context, user *entity.User) error {
+	hours := user.WorkingHours.OrDefault()
+	query, args, err := r.sq.Update("users").Set("username", user.UserName).Set("is_active", user.IsActive).
+		Set("team_name", user.TeamName).Set("max_open_reviews", user.MaxOpenReviews).Set("tags", stringArray(user.Tags)).
+		Set("seniority", user.Seniority.OrDefault()).Set("timezone", hours.Timezone).Set("work_start", hours.Start).
+		Set("work_end", hours.End).Set("email", user.Email).Where(squirrel.Eq{"user_id": user.UserID}).ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build update user: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	_, err = exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return fmt.Errorf("team for user: %w", entity.ErrNotFound)
+		}
+		return fmt.Errorf("exec update user: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresUserRepository) SetMaxOpenReviews(ctx context.Context, userId string, maxOpenReviews *int) error {
+	query, args, err := r.sq.Update("users").Set("max_open_reviews", maxOpenReviews).Where(squirrel.Eq{"user_id": userId}).ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build set max_open_reviews: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	_, err = exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec update max_open_reviews: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresUserRepository) GetUsersAtCapacity(ctx context.Context, teamName string) ([]string, error) {
+	query, args, err := r.sq.Select("u.user_id").From("users u").
+		Join("teams t ON t.team_name = u.team_name").
+		Where(squirrel.Eq{"u.team_name": teamName}).
+		Where("COALESCE(u.max_open_reviews, t.default_max_open_reviews) IS NOT NULL").
+		Where(`(SELECT COUNT(*) FROM pr_reviewers prr
+			JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+			WHERE prr.user_id = u.user_id AND pr.status = ?) >= COALESCE(u.max_open_reviews, t.default_max_open_reviews)`, entity.OPEN).
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build get users at capacity: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec select users at capacity: %w", err)
+	}
+	defer rows.Close()
+
+	usersList := make([]string, 0)
+
+	for rows.Next() {
+		var userId string
+		if err := rows.Scan(&userId); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		usersList = append(usersList, userId)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return usersList, nil
+}
+
+func (r *PostgresUserRepository) SetTags(ctx context.Context, userId string, tags []string) error {
+	query, args, err := r.sq.Update("users").Set("tags", stringArray(tags)).Where(squirrel.Eq{"user_id": userId}).ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build set user tags: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	_, err = exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec update user tags: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresUserRepository) GetTagsByTeam(ctx context.Context, teamName string) (map[string][]string, error) {
+	query, args, err := r.sq.Select("user_id", "tags").From("users").Where(squirrel.Eq{"team_name": teamName}).ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build get tags by team_name: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec select tags by team_name: %w", err)
+	}
+	defer rows.Close()
+
+	tags := make(map[string][]string)
+
+	for rows.Next() {
+		var userId string
+		var userTags []string
+		if err := rows.Scan(&userId, pq.Array(&userTags)); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		tags[userId] = userTags
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return tags, nil
+}
+
+func (r *PostgresUserRepository) SetSeniority(ctx context.Context, userId string, seniority entity.Seniority) error {
+	query, args, err := r.sq.Update("users").Set("seniority", seniority).Where(squirrel.Eq{"user_id": userId}).ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build set user seniority: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	_, err = exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec update user seniority: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresUserRepository) GetSeniorities(ctx context.Context, userIds []string) (map[string]entity.Seniority, error) {
+	query, args, err := r.sq.Select("user_id", "seniority").From("users").Where(squirrel.Eq{"user_id": userIds}).ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build get seniorities: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec select seniorities: %w", err)
+	}
+	defer rows.Close()
+
+	seniorities := make(map[string]entity.Seniority, len(userIds))
+
+	for rows.Next() {
+		var userId string
+		var seniority entity.Seniority
+		if err := rows.Scan(&userId, &seniority); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		seniorities[userId] = seniority
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return seniorities, nil
+}
+
+func (r *PostgresUserRepository) SetWorkingHours(ctx context.Context, userId string, hours entity.WorkingHours) error {
+	query, args, err := r.sq.Update("users").Set("timezone", hours.Timezone).Set("work_start", hours.Start).
+		Set("work_end", hours.End).Where(squirrel.Eq{"user_id": userId}).ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build set user working hours: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	_, err = exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec update user working hours: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresUserRepository) GetWorkingHours(ctx context.Context, userIds []string) (map[string]entity.WorkingHours, error) {
+	query, args, err := r.sq.Select("user_id", "timezone", "work_start", "work_end").From("users").Where(squirrel.Eq{"user_id": userIds}).ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build get working hours: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec select working hours: %w", err)
+	}
+	defer rows.Close()
+
+	workingHours := make(map[string]entity.WorkingHours, len(userIds))
+
+	for rows.Next() {
+		var userId string
+		var hours entity.WorkingHours
+		if err := rows.Scan(&userId, &hours.Timezone, &hours.Start, &hours.End); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		workingHours[userId] = hours
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return workingHours, nil
+}
+
+func (r *PostgresUserRepository) SetEmail(ctx context.Context, userId string, email string) error {
+	query, args, err := r.sq.Update("users").Set("email", email).Where(squirrel.Eq{"user_id": userId}).ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build set user email: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	_, err = exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec update user email: %w", err)
+	}
+
+	return nil
+}
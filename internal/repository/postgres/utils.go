@@ -20,6 +20,13 @@ func isUniqueViolation(err error) bool {
 	return false
 }
 
+func isForeignKeyViolation(err error) bool {
+	if err, ok := err.(*pq.Error); ok {
+		return err.Code == "23503"
+	}
+	return false
+}
+
 func isSerializationFailure(err error) bool {
 	if err, ok := err.(*pq.Error); ok {
 		return err.Code == "40001"
@@ -27,6 +34,13 @@ func isSerializationFailure(err error) bool {
 	return false
 }
 
+func stringArray(v []string) interface{} {
+	if v == nil {
+		v = []string{}
+	}
+	return pq.Array(v)
+}
+
 func executerFromContext(ctx context.Context, db *sql.DB) Execer {
 	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok && tx != nil {
 		return tx
@@ -0,0 +1,313 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"pullrequest-service/internal/entity"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+)
+
+type PostgresWebhookRepository struct {
+	db *sql.DB
+	sq squirrel.StatementBuilderType
+}
+
+func NewPostgresWebhookRepository(db *sql.DB) *PostgresWebhookRepository {
+	return &PostgresWebhookRepository{db: db, sq: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)}
+}
+
+var webhookColumns = []string{"id", "url", "secret", "event_types", "created_at"}
+
+var deliveryColumns = []string{"id", "webhook_id", "event", "status", "attempts", "next_attempt_at", "last_error", "delivered_at", "created_at"}
+
+func (r *PostgresWebhookRepository) CreateWebhook(ctx context.Context, hook *entity.Webhook) (int64, error) {
+	query, args, err := r.sq.Insert("webhooks").Columns("url", "secret", "event_types").
+		Values(hook.URL, hook.Secret, eventTypeArray(hook.EventTypes)).Suffix("RETURNING id").ToSql()
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to build insert webhook: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	var id int64
+	if err := exec.QueryRowContext(ctx, query, args...).Scan(&id); err != nil {
+		return 0, fmt.Errorf("exec insert webhook: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *PostgresWebhookRepository) GetWebhookById(ctx context.Context, id int64) (*entity.Webhook, error) {
+	query, args, err := r.sq.Select(webhookColumns...).From("webhooks").Where(squirrel.Eq{"id": id}).ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select webhook: %w", err)
+	}
+
+	hooks, err := r.queryWebhooks(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(hooks) == 0 {
+		return nil, fmt.Errorf("webhook: %w", entity.ErrNotFound)
+	}
+
+	return &hooks[0], nil
+}
+
+func (r *PostgresWebhookRepository) ListWebhooks(ctx context.Context) ([]entity.Webhook, error) {
+	query, args, err := r.sq.Select(webhookColumns...).From("webhooks").OrderBy("id").ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select webhooks: %w", err)
+	}
+
+	return r.queryWebhooks(ctx, query, args)
+}
+
+func (r *PostgresWebhookRepository) GetWebhooksByEvent(ctx context.Context, eventType entity.EventType) ([]entity.Webhook, error) {
+	query, args, err := r.sq.Select(webhookColumns...).From("webhooks").Where("? = ANY(event_types)", eventType).OrderBy("id").ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select webhooks by event: %w", err)
+	}
+
+	return r.queryWebhooks(ctx, query, args)
+}
+
+func (r *PostgresWebhookRepository) DeleteWebhook(ctx context.Context, id int64) error {
+	query, args, err := r.sq.Delete("webhooks").Where(squirrel.Eq{"id": id}).ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build delete webhook: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	res, err := exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec delete webhook: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected: %w", err)
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("webhook: %w", entity.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *PostgresWebhookRepository) CreateDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	event, err := json.Marshal(delivery.Event)
+	if err != nil {
+		return fmt.Errorf("marshal event: %w", err)
+	}
+
+	query, args, err := r.sq.Insert("webhook_deliveries").Columns("webhook_id", "event_id", "event_type", "event", "next_attempt_at").
+		Values(delivery.WebhookID, delivery.Event.ID, delivery.Event.Type, event, delivery.NextAttemptAt).
+		Suffix("ON CONFLICT (webhook_id, event_id) DO NOTHING").ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build insert webhook delivery: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	_, err = exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return fmt.Errorf("webhook for delivery: %w", entity.ErrNotFound)
+		}
+		return fmt.Errorf("exec insert webhook delivery: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresWebhookRepository) ClaimDueDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit uint64) ([]entity.WebhookDelivery, error) {
+	query, args, err := r.sq.Update("webhook_deliveries").Set("next_attempt_at", now.Add(lease)).
+		Where(`id IN (SELECT id FROM webhook_deliveries WHERE status = ? AND next_attempt_at <= ?
+			ORDER BY next_attempt_at, id LIMIT ? FOR UPDATE SKIP LOCKED)`, entity.DeliveryPending, now, limit).
+		Suffix("RETURNING " + strings.Join(deliveryColumns, ", ")).ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build claim webhook deliveries: %w", err)
+	}
+
+	return r.queryDeliveries(ctx, query, args)
+}
+
+func (r *PostgresWebhookRepository) UpdateDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	query, args, err := r.sq.Update("webhook_deliveries").Set("status", delivery.Status).Set("attempts", delivery.Attempts).
+		Set("next_attempt_at", delivery.NextAttemptAt).Set("last_error", delivery.LastError).Set("delivered_at", delivery.DeliveredAt).
+		Where(squirrel.Eq{"id": delivery.ID}).ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build update webhook delivery: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	_, err = exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec update webhook delivery: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresWebhookRepository) AddAttempt(ctx context.Context, attempt *entity.WebhookAttempt) error {
+	query, args, err := r.sq.Insert("webhook_attempts").Columns("delivery_id", "attempt", "status_code", "error", "duration_ms", "attempted_at").
+		Values(attempt.DeliveryID, attempt.Attempt, attempt.StatusCode, attempt.Error, attempt.Duration.Milliseconds(), attempt.AttemptedAt).ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build insert webhook attempt: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	_, err = exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("exec insert webhook attempt: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresWebhookRepository) ListDeliveries(ctx context.Context, filter *entity.DeliveryFilter) ([]entity.WebhookDelivery, error) {
+	builder := r.sq.Select(deliveryColumns...).From("webhook_deliveries").
+		Where(squirrel.Eq{"webhook_id": filter.WebhookID}).OrderBy("created_at DESC", "id DESC")
+
+	if filter.Status != "" {
+		builder = builder.Where(squirrel.Eq{"status": filter.Status})
+	}
+
+	if filter.Limit > 0 {
+		builder = builder.Limit(filter.Limit)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select webhook deliveries: %w", err)
+	}
+
+	return r.queryDeliveries(ctx, query, args)
+}
+
+func (r *PostgresWebhookRepository) GetAttempts(ctx context.Context, deliveryIds []int64) (map[int64][]entity.WebhookAttempt, error) {
+	query, args, err := r.sq.Select("delivery_id", "attempt", "status_code", "error", "duration_ms", "attempted_at").From("webhook_attempts").
+		Where(squirrel.Eq{"delivery_id": deliveryIds}).OrderBy("delivery_id", "attempt").ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select webhook attempts: %w", err)
+	}
+
+	exec := executerFromContext(ctx, r.db)
+
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec select webhook attempts: %w", err)
+	}
+	defer rows.Close()
+
+	attempts := make(map[int64][]entity.WebhookAttempt, len(deliveryIds))
+
+	for rows.Next() {
+		var attempt entity.WebhookAttempt
+		var durationMs int64
+		if err := rows.Scan(&attempt.DeliveryID, &attempt.Attempt, &attempt.StatusCode, &attempt.Error, &durationMs, &attempt.AttemptedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		attempt.Duration = time.Duration(durationMs) * time.Millisecond
+		attempts[attempt.DeliveryID] = append(attempts[attempt.DeliveryID], attempt)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return attempts, nil
+}
+
+func (r *PostgresWebhookRepository) queryWebhooks(ctx context.Context, query string, args []interface{}) ([]entity.Webhook, error) {
+	exec := executerFromContext(ctx, r.db)
+
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec select webhooks: %w", err)
+	}
+	defer rows.Close()
+
+	hooks := make([]entity.Webhook, 0)
+	for rows.Next() {
+		var hook entity.Webhook
+		var eventTypes []string
+		if err := rows.Scan(&hook.ID, &hook.URL, &hook.Secret, pq.Array(&eventTypes), &hook.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan webhook row: %w", err)
+		}
+
+		for _, eventType := range eventTypes {
+			hook.EventTypes = append(hook.EventTypes, entity.EventType(eventType))
+		}
+
+		hooks = append(hooks, hook)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return hooks, nil
+}
+
+func (r *PostgresWebhookRepository) queryDeliveries(ctx context.Context, query string, args []interface{}) ([]entity.WebhookDelivery, error) {
+	exec := executerFromContext(ctx, r.db)
+
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec select webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := make([]entity.WebhookDelivery, 0)
+	for rows.Next() {
+		var delivery entity.WebhookDelivery
+		var event []byte
+		if err := rows.Scan(&delivery.ID, &delivery.WebhookID, &event, &delivery.Status, &delivery.Attempts, &delivery.NextAttemptAt,
+			&delivery.LastError, &delivery.DeliveredAt, &delivery.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery row: %w", err)
+		}
+
+		if err := json.Unmarshal(event, &delivery.Event); err != nil {
+			return nil, fmt.Errorf("unmarshal event: %w", err)
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+func eventTypeArray(eventTypes []entity.EventType) interface{} {
+	values := make([]string, len(eventTypes))
+	for i, eventType := range eventTypes {
+		values[i] = string(eventType)
+	}
+	return stringArray(values)
+}
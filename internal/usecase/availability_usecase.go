@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"pullrequest-service/internal/entity"
+	"time"
+)
+
+type AvailabilityUsecase struct {
+	availabilityRep AvailabilityRepository
+	userRep         UserRepository
+	prRep           PRRepository
+	reassigner      ReviewReassigner
+	logger          *slog.Logger
+}
+
+func NewAvailabilityUsecase(availabilityRep AvailabilityRepository, userRep UserRepository, prRep PRRepository, reassigner ReviewReassigner, logger *slog.Logger) *AvailabilityUsecase {
+	return &AvailabilityUsecase{availabilityRep: availabilityRep, userRep: userRep, prRep: prRep, reassigner: reassigner, logger: logger}
+}
+
+func (u *AvailabilityUsecase) AddUnavailability(ctx context.Context, window *entity.Unavailability) (*entity.Unavailability, error) {
+	u.logger.Info("start adding unavailability", "user_id", window.UserID, "starts_at", window.StartsAt, "ends_at", window.EndsAt)
+
+	if err := window.Validate(); err != nil {
+		u.logger.Warn("unavailability validation failed", "user_id", window.UserID, "error", err)
+		return nil, err
+	}
+
+	id, err := u.availabilityRep.CreateUnavailability(ctx, window)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			u.logger.Warn("user not found", "user_id", window.UserID, "error", err)
+			return nil, err
+		}
+		u.logger.Error("failed to create unavailability", "user_id", window.UserID, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	created := *window
+	created.ID = id
+
+	u.logger.Info("unavailability added successfully", "user_id", window.UserID, "id", id)
+
+	return &created, nil
+}
+
+func (u *AvailabilityUsecase) GetUnavailabilities(ctx context.Context, userId string) ([]entity.Unavailability, error) {
+	u.logger.Info("start getting unavailability for user", "user_id", userId)
+
+	if userId == "" {
+		u.logger.Warn("invalid user id: empty", "user_id", userId)
+		return nil, entity.ErrInvalidRequest
+	}
+
+	_, err := u.userRep.IsUserExist(ctx, userId)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			u.logger.Warn("user not found", "user_id", userId)
+			return nil, err
+		}
+		u.logger.Error("error checking user existence", "user_id", userId, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	windows, err := u.availabilityRep.GetUnavailabilitiesByUser(ctx, userId)
+	if err != nil {
+		u.logger.Error("failed to get unavailability for user", "user_id", userId, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	u.logger.Info("successfully got unavailability for user", "user_id", userId, "count", len(windows))
+
+	return windows, nil
+}
+
+func (u *AvailabilityUsecase) DeleteUnavailability(ctx context.Context, id int64) error {
+	u.logger.Info("start deleting unavailability", "id", id)
+
+	if id <= 0 {
+		u.logger.Warn("invalid unavailability id", "id", id)
+		return entity.ErrInvalidRequest
+	}
+
+	if err := u.availabilityRep.DeleteUnavailability(ctx, id); err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			u.logger.Warn("unavailability not found", "id", id)
+			return err
+		}
+		u.logger.Error("failed to delete unavailability", "id", id, "error", err)
+		return entity.ErrInternalError
+	}
+
+	u.logger.Info("unavailability deleted successfully", "id", id)
+
+	return nil
+}
+
+func (u *AvailabilityUsecase) ReassignStartedWindows(ctx context.Context) error {
+	now := time.Now()
+
+	windows, err := u.availabilityRep.GetStartedPendingReassignments(ctx, now)
+	if err != nil {
+		u.logger.Error("failed to get pending reassignments", "error", err)
+		return entity.ErrInternalError
+	}
+
+	for _, window := range windows {
+		u.logger.Info("start reassigning reviews of unavailable user", "user_id", window.UserID, "id", window.ID)
+
+		prList, err := u.prRep.GetAllPRForReviewer(ctx, window.UserID)
+		if err != nil {
+			u.logger.Error("failed to get PR list for user", "user_id", window.UserID, "error", err)
+			continue
+		}
+
+		// A window with a failed reassignment is left unmarked, so the job
+		// retries it on the next run; other PRs and windows go on meanwhile.
+		failed := false
+
+		for _, pr := range prList {
+			if pr.Status != entity.OPEN {
+				continue
+			}
+
+			if _, err := u.reassigner.ReAssign(ctx, pr.PullRequestID, window.UserID); err != nil {
+				if errors.Is(err, entity.ErrNoCandidate) {
+					u.logger.Warn("no replacement for unavailable reviewer", "pull_request_id", pr.PullRequestID, "user_id", window.UserID)
+					continue
+				}
+
+				if errors.Is(err, entity.ErrNotAssigned) || errors.Is(err, entity.ErrPRMerged) || errors.Is(err, entity.ErrPRClosed) {
+					u.logger.Info("review no longer needs reassignment", "pull_request_id", pr.PullRequestID, "user_id", window.UserID, "error", err)
+					continue
+				}
+				u.logger.Error("failed to reassign review", "pull_request_id", pr.PullRequestID, "user_id", window.UserID, "error", err)
+				failed = true
+			}
+		}
+
+		if failed {
+			u.logger.Warn("reviews of unavailable user partly reassigned, retrying on the next run", "user_id", window.UserID, "id", window.ID)
+			continue
+		}
+
+		if err := u.availabilityRep.MarkReviewsReassigned(ctx, window.ID, now); err != nil {
+			u.logger.Error("failed to mark reviews reassigned", "id", window.ID, "error", err)
+			continue
+		}
+
+		u.logger.Info("reviews of unavailable user reassigned", "user_id", window.UserID, "id", window.ID)
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"pullrequest-service/internal/entity"
+	"slices"
+	"testing"
+)
+
+func TestReassignStartedWindows(t *testing.T) {
+	availability := &fakeAvailabilityRepository{windows: []entity.Unavailability{
+		{ID: 1, UserID: "u1", ReassignReviews: true},
+		{ID: 2, UserID: "u2", ReassignReviews: true},
+	}}
+	reviews := &fakeReviewRepository{reviews: map[string][]entity.PullRequestShort{
+		"u1": {
+			{PullRequestID: "pr-broken", Status: entity.OPEN},
+			{PullRequestID: "pr-1", Status: entity.OPEN},
+			{PullRequestID: "pr-merged", Status: entity.MERGED},
+		},
+		"u2": {
+			{PullRequestID: "pr-no-candidate", Status: entity.OPEN},
+			{PullRequestID: "pr-2", Status: entity.OPEN},
+		},
+	}}
+	reassigner := &fakeReassigner{errs: map[string]error{
+		"pr-broken":       errors.New("connection reset"),
+		"pr-no-candidate": entity.ErrNoCandidate,
+	}}
+
+	u := NewAvailabilityUsecase(availability, nil, reviews, reassigner, discardLogger())
+
+	if err := u.ReassignStartedWindows(context.Background()); err != nil {
+		t.Fatalf("ReassignStartedWindows() error = %v", err)
+	}
+
+	if want := []string{"pr-broken", "pr-1", "pr-no-candidate", "pr-2"}; !slices.Equal(reassigner.calls, want) {
+		t.Errorf("reassigned = %v, want %v", reassigner.calls, want)
+	}
+
+	if want := []int64{2}; !slices.Equal(availability.marked, want) {
+		t.Errorf("marked windows = %v, want %v: a failed window is retried", availability.marked, want)
+	}
+}
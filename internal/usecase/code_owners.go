@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"pullrequest-service/internal/entity"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+type CodeOwners struct {
+	codeOwnerRep CodeOwnerRepository
+}
+
+func NewCodeOwners(codeOwnerRep CodeOwnerRepository) *CodeOwners {
+	return &CodeOwners{codeOwnerRep: codeOwnerRep}
+}
+
+func (c *CodeOwners) MatchingRules(ctx context.Context, teamName string, files []string) ([]entity.CodeOwnerRule, error) {
+	if len(files) == 0 {
+		return nil, nil
+	}
+
+	rules, err := c.codeOwnerRep.GetRulesByTeam(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("get code owner rules: %w", err)
+	}
+
+	return matchCodeOwnerRules(rules, files)
+}
+
+func IsCodeOwner(rule *entity.CodeOwnerRule, reviewer entity.ReviewerAssignment) bool {
+	return slices.Contains(rule.OwnerUsers, reviewer.UserID) || slices.Contains(rule.OwnerTeams, reviewer.TeamName)
+}
+
+// matchCodeOwnerRules returns the rules owning at least one of the files. As in CODEOWNERS,
+// the last matching rule wins for every file.
+func matchCodeOwnerRules(rules []entity.CodeOwnerRule, files []string) ([]entity.CodeOwnerRule, error) {
+	patterns := make([]*regexp.Regexp, len(rules))
+	for i := range rules {
+		re, err := compileCodeOwnerPattern(rules[i].Pattern)
+		if err != nil {
+			return nil, err
+		}
+		patterns[i] = re
+	}
+
+	matched := make([]entity.CodeOwnerRule, 0)
+	seen := make(map[int64]struct{})
+
+	for _, file := range files {
+		file = strings.TrimPrefix(file, "/")
+
+		for i := len(rules) - 1; i >= 0; i-- {
+			if !patterns[i].MatchString(file) {
+				continue
+			}
+
+			if _, ok := seen[rules[i].ID]; !ok {
+				seen[rules[i].ID] = struct{}{}
+				matched = append(matched, rules[i])
+			}
+			break
+		}
+	}
+
+	return matched, nil
+}
+
+// compileCodeOwnerPattern converts a CODEOWNERS glob into a regexp. A pattern without a slash
+// matches at any depth, a leading slash anchors it to the repository root, "**" spans directories
+// and a match on a directory covers everything below it. As in CODEOWNERS, "dir/*" only matches
+// the direct children of dir.
+func compileCodeOwnerPattern(pattern string) (*regexp.Regexp, error) {
+	p := strings.TrimSuffix(pattern, "/")
+	if p == "" {
+		return nil, fmt.Errorf("%w: invalid pattern %q", entity.ErrInvalidRequest, pattern)
+	}
+
+	if strings.HasPrefix(p, "/") {
+		p = strings.TrimPrefix(p, "/")
+	} else if !strings.Contains(p, "/") {
+		p = "**/" + p
+	}
+
+	var b strings.Builder
+	b.WriteString("^")
+
+	for i := 0; i < len(p); i++ {
+		switch {
+		case strings.HasPrefix(p[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(p[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case p[i] == '*':
+			b.WriteString("[^/]*")
+		case p[i] == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(p[i])))
+		}
+	}
+
+	if strings.HasSuffix(p, "/*") {
+		b.WriteString("$")
+	} else {
+		b.WriteString("(?:/.*)?$")
+	}
+
+	re, err := regexp.Compile(b.String())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid pattern %q", entity.ErrInvalidRequest, pattern)
+	}
+
+	return re, nil
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"errors"
+	"pullrequest-service/internal/entity"
+	"slices"
+	"testing"
+)
+
+func TestCompileCodeOwnerPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		file    string
+		want    bool
+	}{
+		{pattern: "*.go", file: "main.go", want: true},
+		{pattern: "*.go", file: "internal/usecase/pr.go", want: true},
+		{pattern: "*.go", file: "main.go.txt", want: false},
+		{pattern: "Makefile", file: "build/Makefile", want: true},
+		{pattern: "/Makefile", file: "Makefile", want: true},
+		{pattern: "/Makefile", file: "build/Makefile", want: false},
+		{pattern: "docs/*.md", file: "docs/readme.md", want: true},
+		{pattern: "docs/*.md", file: "docs/api/readme.md", want: false},
+		{pattern: "docs/*.md", file: "src/docs/readme.md", want: false},
+		{pattern: "internal/", file: "internal/usecase/pr.go", want: true},
+		{pattern: "/internal", file: "internal", want: true},
+		{pattern: "internal/usecase", file: "internal/usecase_test.go", want: false},
+		{pattern: "sql", file: "db/sql/schema.sql", want: true},
+		{pattern: "**/migrations", file: "db/migrations/001.sql", want: true},
+		{pattern: "**/migrations", file: "migrations/001.sql", want: true},
+		{pattern: "api/**/*.proto", file: "api/v1/pr/pr.proto", want: true},
+		{pattern: "api/**/*.proto", file: "api/pr.proto", want: true},
+		{pattern: "api/**", file: "api/v1/pr.proto", want: true},
+		{pattern: "src/*", file: "src/main.go", want: true},
+		{pattern: "src/*", file: "src/a/b.go", want: false},
+		{pattern: "*", file: "src/a/b.go", want: true},
+		{pattern: "cmd/?ain.go", file: "cmd/main.go", want: true},
+		{pattern: "cmd/?ain.go", file: "cmd/mmain.go", want: false},
+		{pattern: "a+b(c).go", file: "a+b(c).go", want: true},
+		{pattern: "a+b(c).go", file: "aab(c)xgo", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.file, func(t *testing.T) {
+			re, err := compileCodeOwnerPattern(tt.pattern)
+			if err != nil {
+				t.Fatalf("compileCodeOwnerPattern(%q) error = %v", tt.pattern, err)
+			}
+
+			if got := re.MatchString(tt.file); got != tt.want {
+				t.Errorf("%q matches %q = %v, want %v (regexp %s)", tt.pattern, tt.file, got, tt.want, re)
+			}
+		})
+	}
+}
+
+func TestCompileCodeOwnerPatternInvalid(t *testing.T) {
+	for _, pattern := range []string{"", "/"} {
+		if _, err := compileCodeOwnerPattern(pattern); !errors.Is(err, entity.ErrInvalidRequest) {
+			t.Errorf("compileCodeOwnerPattern(%q) error = %v, want ErrInvalidRequest", pattern, err)
+		}
+	}
+}
+
+func TestMatchCodeOwnerRules(t *testing.T) {
+	rules := []entity.CodeOwnerRule{
+		{ID: 1, Pattern: "*", OwnerTeams: []string{"backend"}},
+		{ID: 2, Pattern: "*.sql", OwnerTeams: []string{"dba"}},
+		{ID: 3, Pattern: "/internal/billing/", OwnerUsers: []string{"u1"}},
+		{ID: 4, Pattern: "/docs/", OwnerTeams: []string{"writers"}},
+	}
+
+	tests := []struct {
+		name  string
+		files []string
+		want  []int64
+	}{
+		{name: "catch-all", files: []string{"cmd/main.go"}, want: []int64{1}},
+		{name: "later rule wins", files: []string{"sql/schema.sql"}, want: []int64{2}},
+		{name: "last matching rule wins over an earlier specific one", files: []string{"internal/billing/schema.sql"}, want: []int64{3}},
+		{name: "each rule once", files: []string{"docs/a.md", "docs/b.md", "main.go"}, want: []int64{4, 1}},
+		{name: "leading slash in the file", files: []string{"/docs/a.md"}, want: []int64{4}},
+		{name: "no files", files: nil, want: []int64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matched, err := matchCodeOwnerRules(rules, tt.files)
+			if err != nil {
+				t.Fatalf("matchCodeOwnerRules() error = %v", err)
+			}
+
+			got := make([]int64, len(matched))
+			for i, rule := range matched {
+				got[i] = rule.ID
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("matched rules = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchCodeOwnerRulesInvalidPattern(t *testing.T) {
+	rules := []entity.CodeOwnerRule{{ID: 1, Pattern: "*.go"}, {ID: 2, Pattern: "/"}}
+
+	if _, err := matchCodeOwnerRules(rules, []string{"main.go"}); !errors.Is(err, entity.ErrInvalidRequest) {
+		t.Errorf("matchCodeOwnerRules() error = %v, want ErrInvalidRequest", err)
+	}
+}
@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"pullrequest-service/internal/entity"
+)
+
+type CodeOwnersUsecase struct {
+	codeOwnerRep CodeOwnerRepository
+	teamRep      TeamRepository
+	userRep      UserRepository
+	logger       *slog.Logger
+}
+
+func NewCodeOwnersUsecase(codeOwnerRep CodeOwnerRepository, teamRep TeamRepository, userRep UserRepository, logger *slog.Logger) *CodeOwnersUsecase {
+	return &CodeOwnersUsecase{codeOwnerRep: codeOwnerRep, teamRep: teamRep, userRep: userRep, logger: logger}
+}
+
+func (u *CodeOwnersUsecase) AddRule(ctx context.Context, rule *entity.CodeOwnerRule) (*entity.CodeOwnerRule, error) {
+	u.logger.Info("start adding code owner rule", "team_name", rule.TeamName, "pattern", rule.Pattern)
+
+	if err := rule.Validate(); err != nil {
+		u.logger.Warn("code owner rule validation failed", "team_name", rule.TeamName, "error", err)
+		return nil, err
+	}
+
+	if _, err := compileCodeOwnerPattern(rule.Pattern); err != nil {
+		u.logger.Warn("invalid code owner pattern", "team_name", rule.TeamName, "pattern", rule.Pattern, "error", err)
+		return nil, err
+	}
+
+	for _, ownerTeam := range rule.OwnerTeams {
+		exists, err := u.teamRep.IsTeamExist(ctx, ownerTeam)
+		if err != nil {
+			u.logger.Error("failed to check team existence", "team_name", ownerTeam, "error", err)
+			return nil, entity.ErrInternalError
+		}
+
+		if !exists {
+			u.logger.Warn("owner team not found", "team_name", ownerTeam)
+			return nil, entity.ErrNotFound
+		}
+	}
+
+	for _, ownerUser := range rule.OwnerUsers {
+		_, err := u.userRep.IsUserExist(ctx, ownerUser)
+		if err != nil {
+			if errors.Is(err, entity.ErrNotFound) {
+				u.logger.Warn("owner user not found", "user_id", ownerUser)
+				return nil, err
+			}
+			u.logger.Error("error checking user existence", "user_id", ownerUser, "error", err)
+			return nil, entity.ErrInternalError
+		}
+	}
+
+	id, err := u.codeOwnerRep.CreateRule(ctx, rule)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			u.logger.Warn("team not found", "team_name", rule.TeamName, "error", err)
+			return nil, err
+		}
+		u.logger.Error("failed to create code owner rule", "team_name", rule.TeamName, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	created := *rule
+	created.ID = id
+
+	u.logger.Info("code owner rule added successfully", "team_name", rule.TeamName, "id", id)
+
+	return &created, nil
+}
+
+func (u *CodeOwnersUsecase) GetRules(ctx context.Context, teamName string) ([]entity.CodeOwnerRule, error) {
+	u.logger.Info("start getting code owner rules", "team_name", teamName)
+
+	if teamName == "" {
+		u.logger.Warn("invalid team_name: empty", "team_name", teamName)
+		return nil, entity.ErrInvalidRequest
+	}
+
+	exists, err := u.teamRep.IsTeamExist(ctx, teamName)
+	if err != nil {
+		u.logger.Error("failed to check team existence", "team_name", teamName, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	if !exists {
+		u.logger.Warn("team not found", "team_name", teamName)
+		return nil, entity.ErrNotFound
+	}
+
+	rules, err := u.codeOwnerRep.GetRulesByTeam(ctx, teamName)
+	if err != nil {
+		u.logger.Error("failed to get code owner rules", "team_name", teamName, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	u.logger.Info("successfully got code owner rules", "team_name", teamName, "count", len(rules))
+
+	return rules, nil
+}
+
+func (u *CodeOwnersUsecase) DeleteRule(ctx context.Context, id int64) error {
+	u.logger.Info("start deleting code owner rule", "id", id)
+
+	if id <= 0 {
+		u.logger.Warn("invalid code owner rule id", "id", id)
+		return entity.ErrInvalidRequest
+	}
+
+	if err := u.codeOwnerRep.DeleteRule(ctx, id); err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			u.logger.Warn("code owner rule not found", "id", id)
+			return err
+		}
+		u.logger.Error("failed to delete code owner rule", "id", id, "error", err)
+		return entity.ErrInternalError
+	}
+
+	u.logger.Info("code owner rule deleted successfully", "id", id)
+
+	return nil
+}
@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"pullrequest-service/internal/entity"
+	"time"
 )
 
 type TxManager interface {
@@ -11,8 +12,15 @@ type TxManager interface {
 
 type TeamRepository interface {
 	CreateNewTeam(ctx context.Context, teamName string) error
+	IsTeamExist(ctx context.Context, teamName string) (bool, error)
 	GetTeamNameByUserId(ctx context.Context, userId string) (*string, error)
 	GetTeamByName(ctx context.Context, teamName string) (*entity.Team, error)
+	GetAllTeams(ctx context.Context) ([]entity.Team, error)
+	SetDefaultMaxOpenReviews(ctx context.Context, teamName string, maxOpenReviews *int) error
+	GetFallbackTeams(ctx context.Context, teamName string) ([]string, error)
+	SetFallbackTeams(ctx context.Context, teamName string, fallbackTeams []string) error
+	GetTeamPolicy(ctx context.Context, teamName string) (*entity.TeamPolicy, error)
+	SetTeamPolicy(ctx context.Context, teamName string, policy *entity.TeamPolicy) error
 }
 
 type UserRepository interface {
@@ -22,17 +30,160 @@ type UserRepository interface {
 	GetActiveUsersByTeam(ctx context.Context, teamName string) ([]string, error)
 	IsUserActive(ctx context.Context, userId string) (bool, error)
 	GetUserById(ctx context.Context, userId string) (*entity.User, error)
+	SetUsername(ctx context.Context, userId string, username string) error
+	ListUsers(ctx context.Context, filter *entity.UserFilter) ([]entity.User, error)
+	UpdateUser(ctx context.Context, user *entity.User) error
+	SetMaxOpenReviews(ctx context.Context, userId string, maxOpenReviews *int) error
+	GetUsersAtCapacity(ctx context.Context, teamName string) ([]string, error)
+	SetTags(ctx context.Context, userId string, tags []string) error
+	GetTagsByTeam(ctx context.Context, teamName string) (map[string][]string, error)
+	SetSeniority(ctx context.Context, userId string, seniority entity.Seniority) error
+	SetWorkingHours(ctx context.Context, userId string, hours entity.WorkingHours) error
+	GetWorkingHours(ctx context.Context, userIds []string) (map[string]entity.WorkingHours, error)
+	GetSeniorities(ctx context.Context, userIds []string) (map[string]entity.Seniority, error)
+	SetEmail(ctx context.Context, userId string, email string) error
 }
 
 type PRRepository interface {
 	GetAllPRForReviewer(ctx context.Context, userId string) ([]entity.PullRequestShort, error)
-	CreatePR(ctx context.Context, pr *entity.PullRequestShort) error
+	CreatePR(ctx context.Context, pr *entity.PullRequest) error
 	AddReviewerForPR(ctx context.Context, prId string, userId string) error
 	MergePR(ctx context.Context, prId string) error
+	SetPRStatus(ctx context.Context, prId string, status entity.Status) error
 	IsReviewerForPR(ctx context.Context, prId string, userId string) (bool, error)
 	IsPROpen(ctx context.Context, prId string) (bool, error)
 	DeleteReviewer(ctx context.Context, prId string, userId string) error
 	GetPRById(ctx context.Context, prId string) (*entity.PullRequest, error)
 	GetReviewersIdByPR(ctx context.Context, prId string) ([]string, error)
 	IsPRExist(ctx context.Context, prId string) (bool, error)
+	GetAllPRs(ctx context.Context) ([]entity.PullRequest, error)
+	InsertPR(ctx context.Context, pr *entity.PullRequest) error
+	UpdatePR(ctx context.Context, pr *entity.PullRequest) error
+	DeleteAllReviewers(ctx context.Context, prId string) error
+	GetRecentReviewerCounts(ctx context.Context, authorId string, window int) (map[string]int, error)
+	AddAssignmentRecord(ctx context.Context, record *entity.AssignmentRecord) error
+	GetAssignmentHistory(ctx context.Context, prId string) ([]entity.AssignmentRecord, error)
+	GetReviewerStats(ctx context.Context, userId string) (*entity.ReviewerStats, error)
+	GetPendingSLAReviews(ctx context.Context) ([]entity.SLAReview, error)
+	MarkReviewOverdue(ctx context.Context, prId, userId string, at time.Time) error
+	MarkReviewResponded(ctx context.Context, prId, userId string, at time.Time) error
+	GetReviewStates(ctx context.Context, prId string) ([]entity.ReviewState, error)
+	GetOverduePRs(ctx context.Context) ([]entity.PullRequest, error)
+}
+
+type AvailabilityRepository interface {
+	CreateUnavailability(ctx context.Context, u *entity.Unavailability) (int64, error)
+	GetUnavailabilitiesByUser(ctx context.Context, userId string) ([]entity.Unavailability, error)
+	DeleteUnavailability(ctx context.Context, id int64) error
+	GetUnavailableUsersByTeam(ctx context.Context, teamName string, at time.Time) ([]string, error)
+	GetStartedPendingReassignments(ctx context.Context, at time.Time) ([]entity.Unavailability, error)
+	MarkReviewsReassigned(ctx context.Context, id int64, at time.Time) error
+}
+
+type ReviewReassigner interface {
+	ReAssign(ctx context.Context, prId, oldReviewerId string) (*entity.PullRequest, error)
+}
+
+type PRLifecycle interface {
+	CreatePR(ctx context.Context, req *entity.CreatePRRequest) (*entity.PullRequest, error)
+	MergePR(ctx context.Context, prId string) (*entity.PullRequest, error)
+	ClosePR(ctx context.Context, prId string) (*entity.PullRequest, error)
+	ReopenPR(ctx context.Context, prId string) (*entity.PullRequest, error)
+}
+
+type CodeOwnerRepository interface {
+	CreateRule(ctx context.Context, rule *entity.CodeOwnerRule) (int64, error)
+	GetRulesByTeam(ctx context.Context, teamName string) ([]entity.CodeOwnerRule, error)
+	DeleteRule(ctx context.Context, id int64) error
+}
+
+type WebhookRepository interface {
+	CreateWebhook(ctx context.Context, hook *entity.Webhook) (int64, error)
+	GetWebhookById(ctx context.Context, id int64) (*entity.Webhook, error)
+	ListWebhooks(ctx context.Context) ([]entity.Webhook, error)
+	GetWebhooksByEvent(ctx context.Context, eventType entity.EventType) ([]entity.Webhook, error)
+	DeleteWebhook(ctx context.Context, id int64) error
+	CreateDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error
+	ClaimDueDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit uint64) ([]entity.WebhookDelivery, error)
+	UpdateDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error
+	AddAttempt(ctx context.Context, attempt *entity.WebhookAttempt) error
+	ListDeliveries(ctx context.Context, filter *entity.DeliveryFilter) ([]entity.WebhookDelivery, error)
+	GetAttempts(ctx context.Context, deliveryIds []int64) (map[int64][]entity.WebhookAttempt, error)
+}
+
+type WebhookSender interface {
+	Send(ctx context.Context, hook *entity.Webhook, delivery *entity.WebhookDelivery) (int, error)
+}
+
+type EventPublisher interface {
+	Publish(ctx context.Context, event entity.Event) error
+}
+
+// EventSink receives relayed outbox events. Transactional sinks only write to
+// the database and are delivered in the transaction that records the
+// delivery; the others are called outside any transaction.
+type EventSink interface {
+	Name() string
+	Transactional() bool
+	Deliver(ctx context.Context, event entity.Event) error
+}
+
+type OutboxRepository interface {
+	AddEvent(ctx context.Context, event entity.Event) error
+	TryLockRelay(ctx context.Context) (func(), bool, error)
+	GetPendingEvents(ctx context.Context, now time.Time, limit uint64) ([]entity.OutboxEvent, error)
+	MarkSinkDelivered(ctx context.Context, id int64, sink string) error
+	MarkEventProcessed(ctx context.Context, id int64, at time.Time) error
+	MarkEventFailed(ctx context.Context, id int64, lastError string, nextAttemptAt time.Time) error
+	MarkEventDead(ctx context.Context, id int64, lastError string, at time.Time) error
+	GetEventsAfter(ctx context.Context, eventId string, limit uint64) ([]entity.Event, error)
+}
+
+type EventHub interface {
+	Subscribe(buffer int) (<-chan entity.Event, func())
+}
+
+type AccountRepository interface {
+	SetAccount(ctx context.Context, account *entity.ExternalAccount) error
+	GetUserIdByLogin(ctx context.Context, provider entity.Provider, login string) (string, error)
+	ListAccounts(ctx context.Context, provider entity.Provider) ([]entity.ExternalAccount, error)
+	DeleteAccount(ctx context.Context, provider entity.Provider, login string) error
+	QueueUnmapped(ctx context.Context, login string, event *entity.ExternalPREvent) error
+	GetUnmappedLoginByPR(ctx context.Context, provider entity.Provider, prId string) (string, error)
+	ListUnmapped(ctx context.Context, provider entity.Provider, login string) ([]entity.UnmappedEvent, error)
+	DeleteUnmapped(ctx context.Context, id int64) error
+	GetLoginsByUsers(ctx context.Context, provider entity.Provider, userIds []string) (map[string]string, error)
+}
+
+type ReviewerPublisher interface {
+	Provider() entity.Provider
+	Owns(prId string) bool
+	PublishReviewers(ctx context.Context, update *entity.ReviewerUpdate) error
+}
+
+type AuthorResolver interface {
+	Provider() entity.Provider
+	Username(ctx context.Context, userId string) (string, error)
+}
+
+type NotificationRepository interface {
+	SetPreferences(ctx context.Context, prefs *entity.NotificationPreferences) error
+	GetPreferences(ctx context.Context, userId string) (*entity.NotificationPreferences, error)
+	CreateNotification(ctx context.Context, n *entity.Notification) error
+	ClaimDueNotifications(ctx context.Context, digest bool, now time.Time, lease time.Duration, limit uint64) ([]entity.Notification, error)
+	UpdateNotification(ctx context.Context, n *entity.Notification) error
+}
+
+type ChatSender interface {
+	Kind() entity.ChatKind
+	Send(ctx context.Context, channel, text string) error
+}
+
+type DigestRepository interface {
+	ClaimDigest(ctx context.Context, userId, date string) (bool, error)
+	ReleaseDigest(ctx context.Context, userId, date string) error
+}
+
+type DigestMailer interface {
+	SendDigest(ctx context.Context, digest *entity.ReviewDigest) error
 }
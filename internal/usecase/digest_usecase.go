@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"pullrequest-service/internal/entity"
+	"slices"
+	"time"
+)
+
+type DigestUsecase struct {
+	prRep     PRRepository
+	userRep   UserRepository
+	teamRep   TeamRepository
+	digestRep DigestRepository
+	mailer    DigestMailer
+	logger    *slog.Logger
+}
+
+func NewDigestUsecase(prRep PRRepository, userRep UserRepository, teamRep TeamRepository, digestRep DigestRepository, mailer DigestMailer,
+	logger *slog.Logger) *DigestUsecase {
+	return &DigestUsecase{prRep: prRep, userRep: userRep, teamRep: teamRep, digestRep: digestRep, mailer: mailer, logger: logger}
+}
+
+func (u *DigestUsecase) SendDigests(ctx context.Context) error {
+	now := time.Now()
+	active := true
+
+	users, err := u.userRep.ListUsers(ctx, &entity.UserFilter{IsActive: &active})
+	if err != nil {
+		u.logger.Error("failed to list active users", "error", err)
+		return entity.ErrInternalError
+	}
+
+	slaHours := make(map[string]int)
+	sent, failed := 0, 0
+
+	for _, user := range users {
+		if user.Email == "" || !user.WorkingHours.IsWorking(now) {
+			continue
+		}
+
+		date := user.WorkingHours.LocalDate(now)
+
+		reviews, err := u.pendingReviews(ctx, user.UserID, now, slaHours)
+		if err != nil {
+			return err
+		}
+
+		if len(reviews) == 0 {
+			continue
+		}
+
+		claimed, err := u.digestRep.ClaimDigest(ctx, user.UserID, date)
+		if err != nil {
+			u.logger.Error("failed to claim digest", "user_id", user.UserID, "date", date, "error", err)
+			return entity.ErrInternalError
+		}
+
+		if !claimed {
+			continue
+		}
+
+		digest := &entity.ReviewDigest{User: user, Reviews: reviews, GeneratedAt: now}
+		if err := u.mailer.SendDigest(ctx, digest); err != nil {
+			failed++
+			u.logger.Warn("failed to send review digest", "user_id", user.UserID, "error", err)
+
+			if err := u.digestRep.ReleaseDigest(ctx, user.UserID, date); err != nil {
+				u.logger.Error("failed to release digest", "user_id", user.UserID, "date", date, "error", err)
+				return entity.ErrInternalError
+			}
+			continue
+		}
+
+		sent++
+		u.logger.Info("review digest sent", "user_id", user.UserID, "reviews", len(reviews))
+	}
+
+	if sent > 0 || failed > 0 {
+		u.logger.Info("review digests processed", "sent", sent, "failed", failed)
+	}
+
+	return nil
+}
+
+func (u *DigestUsecase) pendingReviews(ctx context.Context, userId string, now time.Time, slaHours map[string]int) ([]entity.DigestReview, error) {
+	prList, err := u.prRep.GetAllPRForReviewer(ctx, userId)
+	if err != nil {
+		u.logger.Error("failed to get PRs for reviewer", "user_id", userId, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	reviews := make([]entity.DigestReview, 0)
+
+	for _, pr := range prList {
+		if pr.Status != entity.OPEN {
+			continue
+		}
+
+		states, err := u.prRep.GetReviewStates(ctx, pr.PullRequestID)
+		if err != nil {
+			u.logger.Error("failed to get review states", "pull_request_id", pr.PullRequestID, "error", err)
+			return nil, entity.ErrInternalError
+		}
+
+		idx := slices.IndexFunc(states, func(state entity.ReviewState) bool { return state.ReviewerID == userId })
+		if idx < 0 || !states[idx].Pending() {
+			continue
+		}
+		state := states[idx]
+
+		hours, err := u.authorSLAHours(ctx, pr.AuthorID, slaHours)
+		if err != nil {
+			return nil, err
+		}
+
+		review := entity.DigestReview{
+			PullRequestID:   pr.PullRequestID,
+			PullRequestName: pr.PullRequestName,
+			AuthorID:        pr.AuthorID,
+			AssignedAt:      state.AssignedAt,
+			Age:             now.Sub(state.AssignedAt),
+			WorkingAge:      state.WorkingHours.Elapsed(state.AssignedAt, now),
+			SLAStatus:       entity.SLAStatusNone,
+		}
+
+		if hours > 0 {
+			sla := time.Duration(hours) * time.Hour
+			if state.Overdue() || review.WorkingAge >= sla {
+				review.SLAStatus = entity.SLAStatusOverdue
+			} else {
+				review.SLAStatus = entity.SLAStatusOnTrack
+				review.SLADueIn = sla - review.WorkingAge
+			}
+		}
+
+		reviews = append(reviews, review)
+	}
+
+	slices.SortFunc(reviews, func(a, b entity.DigestReview) int { return a.AssignedAt.Compare(b.AssignedAt) })
+
+	return reviews, nil
+}
+
+func (u *DigestUsecase) authorSLAHours(ctx context.Context, authorId string, cache map[string]int) (int, error) {
+	author, err := u.userRep.GetUserById(ctx, authorId)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return 0, nil
+		}
+		u.logger.Error("failed to get PR author", "user_id", authorId, "error", err)
+		return 0, entity.ErrInternalError
+	}
+
+	if hours, ok := cache[author.TeamName]; ok {
+		return hours, nil
+	}
+
+	policy, err := u.teamRep.GetTeamPolicy(ctx, author.TeamName)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			cache[author.TeamName] = 0
+			return 0, nil
+		}
+		u.logger.Error("failed to get team policy", "team_name", author.TeamName, "error", err)
+		return 0, entity.ErrInternalError
+	}
+
+	cache[author.TeamName] = policy.ReviewSLAHours
+	return policy.ReviewSLAHours, nil
+}
@@ -0,0 +1,295 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/mail"
+	"pullrequest-service/internal/entity"
+)
+
+type DumpUsecase struct {
+	teamRep TeamRepository
+	userRep UserRepository
+	prRep   PRRepository
+	txMgr   TxManager
+	logger  *slog.Logger
+}
+
+func NewDumpUsecase(teamRep TeamRepository, userRep UserRepository, prRep PRRepository, txMgr TxManager, logger *slog.Logger) *DumpUsecase {
+	return &DumpUsecase{teamRep: teamRep, userRep: userRep, prRep: prRep, txMgr: txMgr, logger: logger}
+}
+
+func (u *DumpUsecase) Export(ctx context.Context) (*entity.Dump, error) {
+	u.logger.Info("start exporting data")
+
+	dump := &entity.Dump{}
+
+	operation := func(ctx context.Context) error {
+		teams, err := u.teamRep.GetAllTeams(ctx)
+		if err != nil {
+			u.logger.Error("failed to get teams", "error", err)
+			return entity.ErrInternalError
+		}
+
+		users, err := u.userRep.ListUsers(ctx, &entity.UserFilter{})
+		if err != nil {
+			u.logger.Error("failed to get users", "error", err)
+			return entity.ErrInternalError
+		}
+
+		prList, err := u.prRep.GetAllPRs(ctx)
+		if err != nil {
+			u.logger.Error("failed to get PR list", "error", err)
+			return entity.ErrInternalError
+		}
+
+		dump = &entity.Dump{Teams: teams, Users: users, PullRequests: prList}
+		return nil
+	}
+
+	err := withRetry(ctx, func(ctx context.Context) error {
+		return u.txMgr.WithTx(ctx, operation)
+	}, 3)
+
+	if err != nil {
+		return nil, err
+	}
+
+	u.logger.Info("data exported successfully", "teams", len(dump.Teams), "users", len(dump.Users), "pull_requests", len(dump.PullRequests))
+
+	return dump, nil
+}
+
+func (u *DumpUsecase) Import(ctx context.Context, dump *entity.Dump, policy entity.ConflictPolicy) (*entity.ImportResult, error) {
+	u.logger.Info("start importing data", "teams", len(dump.Teams), "users", len(dump.Users), "pull_requests", len(dump.PullRequests), "policy", policy)
+
+	if err := policy.Validate(); err != nil {
+		u.logger.Warn("invalid conflict policy", "policy", policy, "error", err)
+		return nil, err
+	}
+
+	var result *entity.ImportResult
+
+	operation := func(ctx context.Context) error {
+		result = &entity.ImportResult{}
+
+		for i := range dump.Teams {
+			if err := u.importTeam(ctx, &dump.Teams[i], policy, &result.Teams); err != nil {
+				return err
+			}
+		}
+
+		for i := range dump.Users {
+			if err := u.importUser(ctx, &dump.Users[i], policy, &result.Users); err != nil {
+				return err
+			}
+		}
+
+		for i := range dump.PullRequests {
+			if err := u.importPR(ctx, &dump.PullRequests[i], policy, &result.PullRequests); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	err := withRetry(ctx, func(ctx context.Context) error {
+		return u.txMgr.WithTx(ctx, operation)
+	}, 3)
+
+	if err != nil {
+		return nil, err
+	}
+
+	u.logger.Info("data imported successfully", "policy", policy)
+
+	return result, nil
+}
+
+func (u *DumpUsecase) importTeam(ctx context.Context, team *entity.Team, policy entity.ConflictPolicy, stats *entity.ImportStats) error {
+	if team.TeamName == "" {
+		u.logger.Warn("invalid team_name: empty")
+		return fmt.Errorf("%w: empty team_name", entity.ErrInvalidRequest)
+	}
+
+	if err := entity.ValidateMaxOpenReviews(team.DefaultMaxOpenReviews); err != nil {
+		u.logger.Warn("invalid team default max open reviews", "team_name", team.TeamName, "error", err)
+		return err
+	}
+
+	err := u.teamRep.CreateNewTeam(ctx, team.TeamName)
+	switch {
+	case err == nil:
+		stats.Created++
+
+	case !errors.Is(err, entity.ErrTeamExists):
+		u.logger.Error("failed to create team", "team_name", team.TeamName, "error", err)
+		return entity.ErrInternalError
+
+	case policy == entity.ConflictFail:
+		u.logger.Warn("team already exists", "team_name", team.TeamName)
+		return fmt.Errorf("%w: team %s", entity.ErrImportConflict, team.TeamName)
+
+	case policy == entity.ConflictSkip:
+		stats.Skipped++
+		return nil
+
+	default:
+		stats.Updated++
+	}
+
+	if err := u.teamRep.SetDefaultMaxOpenReviews(ctx, team.TeamName, team.DefaultMaxOpenReviews); err != nil {
+		u.logger.Error("failed to set default max open reviews", "team_name", team.TeamName, "error", err)
+		return entity.ErrInternalError
+	}
+
+	return nil
+}
+
+func (u *DumpUsecase) importUser(ctx context.Context, user *entity.User, policy entity.ConflictPolicy, stats *entity.ImportStats) error {
+	if user.UserID == "" || user.UserName == "" || user.TeamName == "" {
+		u.logger.Warn("invalid user data: empty fields", "user_id", user.UserID)
+		return fmt.Errorf("%w: empty user data", entity.ErrInvalidRequest)
+	}
+
+	if err := entity.ValidateMaxOpenReviews(user.MaxOpenReviews); err != nil {
+		u.logger.Warn("invalid user max open reviews", "user_id", user.UserID, "error", err)
+		return err
+	}
+
+	if user.Seniority != "" {
+		if err := user.Seniority.Validate(); err != nil {
+			u.logger.Warn("invalid user seniority", "user_id", user.UserID, "error", err)
+			return err
+		}
+	}
+
+	if err := user.WorkingHours.Validate(); err != nil {
+		u.logger.Warn("invalid user working hours", "user_id", user.UserID, "error", err)
+		return err
+	}
+
+	if user.Email != "" {
+		addr, err := mail.ParseAddress(user.Email)
+		if err != nil {
+			u.logger.Warn("invalid user email", "user_id", user.UserID, "error", err)
+			return fmt.Errorf("%w: invalid email of user %s", entity.ErrInvalidRequest, user.UserID)
+		}
+		user.Email = addr.Address
+	}
+
+	_, err := u.userRep.IsUserExist(ctx, user.UserID)
+	if err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			u.logger.Error("failed to check user existence", "user_id", user.UserID, "error", err)
+			return entity.ErrInternalError
+		}
+
+		if err := u.userRep.AddUserToTeam(ctx, user); err != nil {
+			if errors.Is(err, entity.ErrNotFound) {
+				u.logger.Warn("team not found", "user_id", user.UserID, "team_name", user.TeamName, "error", err)
+				return err
+			}
+			u.logger.Error("failed to add user to team", "user_id", user.UserID, "error", err)
+			return entity.ErrInternalError
+		}
+
+		stats.Created++
+		return nil
+	}
+
+	switch policy {
+	case entity.ConflictFail:
+		u.logger.Warn("user already exists", "user_id", user.UserID)
+		return fmt.Errorf("%w: user %s", entity.ErrImportConflict, user.UserID)
+
+	case entity.ConflictOverwrite:
+		if err := u.userRep.UpdateUser(ctx, user); err != nil {
+			if errors.Is(err, entity.ErrNotFound) {
+				u.logger.Warn("team not found", "user_id", user.UserID, "team_name", user.TeamName, "error", err)
+				return err
+			}
+			u.logger.Error("failed to update user", "user_id", user.UserID, "error", err)
+			return entity.ErrInternalError
+		}
+		stats.Updated++
+
+	default:
+		stats.Skipped++
+	}
+
+	return nil
+}
+
+func (u *DumpUsecase) importPR(ctx context.Context, pr *entity.PullRequest, policy entity.ConflictPolicy, stats *entity.ImportStats) error {
+	if pr.PullRequestID == "" || pr.PullRequestName == "" || pr.AuthorID == "" {
+		u.logger.Warn("invalid PR data: empty fields", "pull_request_id", pr.PullRequestID)
+		return fmt.Errorf("%w: empty PR data", entity.ErrInvalidRequest)
+	}
+
+	if pr.Status != entity.OPEN && pr.Status != entity.MERGED && pr.Status != entity.CLOSED {
+		u.logger.Warn("invalid PR status", "pull_request_id", pr.PullRequestID, "status", pr.Status)
+		return fmt.Errorf("%w: unknown PR status %q", entity.ErrInvalidRequest, pr.Status)
+	}
+
+	exist, err := u.prRep.IsPRExist(ctx, pr.PullRequestID)
+	if err != nil && !errors.Is(err, entity.ErrNotFound) {
+		u.logger.Error("failed to check PR existence", "pull_request_id", pr.PullRequestID, "error", err)
+		return entity.ErrInternalError
+	}
+
+	if exist {
+		switch policy {
+		case entity.ConflictFail:
+			u.logger.Warn("PR exists", "pull_request_id", pr.PullRequestID)
+			return fmt.Errorf("%w: pull request %s", entity.ErrImportConflict, pr.PullRequestID)
+
+		case entity.ConflictSkip:
+			stats.Skipped++
+			return nil
+		}
+
+		if err := u.prRep.UpdatePR(ctx, pr); err != nil {
+			if errors.Is(err, entity.ErrNotFound) {
+				u.logger.Warn("author not found", "pull_request_id", pr.PullRequestID, "author_id", pr.AuthorID, "error", err)
+				return err
+			}
+			u.logger.Error("failed to update PR", "pull_request_id", pr.PullRequestID, "error", err)
+			return entity.ErrInternalError
+		}
+
+		if err := u.prRep.DeleteAllReviewers(ctx, pr.PullRequestID); err != nil {
+			u.logger.Error("failed to delete reviewers for PR", "pull_request_id", pr.PullRequestID, "error", err)
+			return entity.ErrInternalError
+		}
+
+		stats.Updated++
+	} else {
+		if err := u.prRep.InsertPR(ctx, pr); err != nil {
+			if errors.Is(err, entity.ErrNotFound) {
+				u.logger.Warn("author not found", "pull_request_id", pr.PullRequestID, "author_id", pr.AuthorID, "error", err)
+				return err
+			}
+			u.logger.Error("failed to insert PR", "pull_request_id", pr.PullRequestID, "error", err)
+			return entity.ErrInternalError
+		}
+
+		stats.Created++
+	}
+
+	for _, reviewerId := range pr.AssignedReviewers {
+		if err := u.prRep.AddReviewerForPR(ctx, pr.PullRequestID, reviewerId); err != nil {
+			if errors.Is(err, entity.ErrNotFound) {
+				u.logger.Warn("reviewer not found", "pull_request_id", pr.PullRequestID, "reviewer_id", reviewerId, "error", err)
+				return err
+			}
+			u.logger.Error("failed to add reviewer to PR", "pull_request_id", pr.PullRequestID, "reviewer_id", reviewerId, "error", err)
+			return entity.ErrInternalError
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"pullrequest-service/internal/entity"
+)
+
+func publishEvents(ctx context.Context, publisher EventPublisher, logger *slog.Logger, events ...entity.Event) error {
+	for _, event := range events {
+		if err := publisher.Publish(ctx, event); err != nil {
+			logger.Error("failed to publish event", "event_id", event.ID, "type", event.Type, "error", err)
+			return entity.ErrInternalError
+		}
+	}
+	return nil
+}
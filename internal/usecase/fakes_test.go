@@ -0,0 +1,359 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"pullrequest-service/internal/entity"
+	"slices"
+	"time"
+)
+
+func discardLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+type fakeAccountRepository struct {
+	AccountRepository
+	logins map[string]string
+}
+
+func (r *fakeAccountRepository) GetLoginsByUsers(ctx context.Context, provider entity.Provider, userIds []string) (map[string]string, error) {
+	logins := make(map[string]string)
+	for _, userId := range userIds {
+		if login, ok := r.logins[userId]; ok {
+			logins[userId] = login
+		}
+	}
+	return logins, nil
+}
+
+type fakeWebhookRepository struct {
+	WebhookRepository
+	hooks      map[int64]*entity.Webhook
+	deliveries []entity.WebhookDelivery
+	attempts   []entity.WebhookAttempt
+	updated    map[int64]entity.WebhookDelivery
+}
+
+func (r *fakeWebhookRepository) GetWebhookById(ctx context.Context, id int64) (*entity.Webhook, error) {
+	hook, ok := r.hooks[id]
+	if !ok {
+		return nil, entity.ErrNotFound
+	}
+	return hook, nil
+}
+
+func (r *fakeWebhookRepository) ClaimDueDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit uint64) ([]entity.WebhookDelivery, error) {
+	due := make([]entity.WebhookDelivery, 0)
+	for _, delivery := range r.deliveries {
+		if delivery.Status == entity.DeliveryPending && !delivery.NextAttemptAt.After(now) {
+			due = append(due, delivery)
+		}
+	}
+	return due, nil
+}
+
+func (r *fakeWebhookRepository) AddAttempt(ctx context.Context, attempt *entity.WebhookAttempt) error {
+	r.attempts = append(r.attempts, *attempt)
+	return nil
+}
+
+func (r *fakeWebhookRepository) UpdateDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	if r.updated == nil {
+		r.updated = make(map[int64]entity.WebhookDelivery)
+	}
+	r.updated[delivery.ID] = *delivery
+	return nil
+}
+
+func (r *fakeAccountRepository) GetUserIdByLogin(ctx context.Context, provider entity.Provider, login string) (string, error) {
+	for userId, mapped := range r.logins {
+		if mapped == login {
+			return userId, nil
+		}
+	}
+	return "", entity.ErrNotFound
+}
+
+type fakeAuthorResolver struct {
+	usernames map[string]string
+}
+
+func (r *fakeAuthorResolver) Provider() entity.Provider {
+	return entity.ProviderGitLab
+}
+
+func (r *fakeAuthorResolver) Username(ctx context.Context, userId string) (string, error) {
+	username, ok := r.usernames[userId]
+	if !ok {
+		return "", entity.ErrCodeHostRejected
+	}
+	return username, nil
+}
+
+type fakePRLifecycle struct {
+	prs   map[string]*entity.PullRequest
+	calls []string
+}
+
+func (f *fakePRLifecycle) CreatePR(ctx context.Context, req *entity.CreatePRRequest) (*entity.PullRequest, error) {
+	f.calls = append(f.calls, "create")
+	if _, ok := f.prs[req.PullRequestID]; ok {
+		return nil, entity.ErrPRExists
+	}
+	pr := &entity.PullRequest{PullRequestID: req.PullRequestID, PullRequestName: req.PullRequestName, AuthorID: req.AuthorID, Status: entity.OPEN}
+	f.prs[req.PullRequestID] = pr
+	return pr, nil
+}
+
+func (f *fakePRLifecycle) MergePR(ctx context.Context, prId string) (*entity.PullRequest, error) {
+	return f.setStatus("merge", prId, entity.MERGED)
+}
+
+func (f *fakePRLifecycle) ClosePR(ctx context.Context, prId string) (*entity.PullRequest, error) {
+	return f.setStatus("close", prId, entity.CLOSED)
+}
+
+func (f *fakePRLifecycle) ReopenPR(ctx context.Context, prId string) (*entity.PullRequest, error) {
+	return f.setStatus("reopen", prId, entity.OPEN)
+}
+
+func (f *fakePRLifecycle) setStatus(call string, prId string, status entity.Status) (*entity.PullRequest, error) {
+	f.calls = append(f.calls, call)
+	pr, ok := f.prs[prId]
+	if !ok {
+		return nil, entity.ErrNotFound
+	}
+	pr.Status = status
+	return pr, nil
+}
+
+type fakeNotificationRepository struct {
+	NotificationRepository
+	prefs         map[string]*entity.NotificationPreferences
+	notifications []*entity.Notification
+}
+
+func (r *fakeNotificationRepository) GetPreferences(ctx context.Context, userId string) (*entity.NotificationPreferences, error) {
+	prefs, ok := r.prefs[userId]
+	if !ok {
+		return nil, entity.ErrNotFound
+	}
+	return prefs, nil
+}
+
+func (r *fakeNotificationRepository) CreateNotification(ctx context.Context, n *entity.Notification) error {
+	n.ID = int64(len(r.notifications) + 1)
+	n.Status = entity.DeliveryPending
+	r.notifications = append(r.notifications, n)
+	return nil
+}
+
+func (r *fakeNotificationRepository) ClaimDueNotifications(ctx context.Context, digest bool, now time.Time, lease time.Duration, limit uint64) ([]entity.Notification, error) {
+	due := make([]entity.Notification, 0)
+	for _, n := range r.notifications {
+		if n.Status == entity.DeliveryPending && n.Digest == digest {
+			due = append(due, *n)
+		}
+	}
+	return due, nil
+}
+
+func (r *fakeNotificationRepository) UpdateNotification(ctx context.Context, n *entity.Notification) error {
+	for i, stored := range r.notifications {
+		if stored.ID == n.ID {
+			updated := *n
+			r.notifications[i] = &updated
+		}
+	}
+	return nil
+}
+
+type fakeUserRepository struct {
+	UserRepository
+	users map[string]*entity.User
+}
+
+func (r *fakeUserRepository) IsUserExist(ctx context.Context, userId string) (bool, error) {
+	if _, ok := r.users[userId]; !ok {
+		return false, entity.ErrNotFound
+	}
+	return true, nil
+}
+
+func (r *fakeUserRepository) GetUserById(ctx context.Context, userId string) (*entity.User, error) {
+	user, ok := r.users[userId]
+	if !ok {
+		return nil, entity.ErrNotFound
+	}
+	return user, nil
+}
+
+func (r *fakeUserRepository) SetEmail(ctx context.Context, userId string, email string) error {
+	r.users[userId].Email = email
+	return nil
+}
+
+type fakeTxKey struct{}
+
+type fakeTxManager struct{}
+
+func (m fakeTxManager) WithTx(ctx context.Context, fn func(context.Context) error) error {
+	return fn(context.WithValue(ctx, fakeTxKey{}, true))
+}
+
+func inFakeTx(ctx context.Context) bool {
+	inTx, _ := ctx.Value(fakeTxKey{}).(bool)
+	return inTx
+}
+
+type fakeOutboxRepository struct {
+	OutboxRepository
+	events []*entity.OutboxEvent
+}
+
+func (r *fakeOutboxRepository) TryLockRelay(ctx context.Context) (func(), bool, error) {
+	return func() {}, true, nil
+}
+
+func (r *fakeOutboxRepository) GetPendingEvents(ctx context.Context, now time.Time, limit uint64) ([]entity.OutboxEvent, error) {
+	pending := make([]entity.OutboxEvent, 0)
+	for _, event := range r.events {
+		if event.ProcessedAt == nil && event.DeadAt == nil && !event.NextAttemptAt.After(now) {
+			pending = append(pending, *event)
+			pending[len(pending)-1].DeliveredSinks = slices.Clone(event.DeliveredSinks)
+		}
+	}
+	return pending, nil
+}
+
+func (r *fakeOutboxRepository) event(id int64) *entity.OutboxEvent {
+	for _, event := range r.events {
+		if event.ID == id {
+			return event
+		}
+	}
+	return nil
+}
+
+func (r *fakeOutboxRepository) MarkSinkDelivered(ctx context.Context, id int64, sink string) error {
+	event := r.event(id)
+	event.DeliveredSinks = append(event.DeliveredSinks, sink)
+	return nil
+}
+
+func (r *fakeOutboxRepository) MarkEventProcessed(ctx context.Context, id int64, at time.Time) error {
+	r.event(id).ProcessedAt = &at
+	return nil
+}
+
+func (r *fakeOutboxRepository) MarkEventFailed(ctx context.Context, id int64, lastError string, nextAttemptAt time.Time) error {
+	event := r.event(id)
+	event.Attempts++
+	event.LastError = lastError
+	event.NextAttemptAt = nextAttemptAt
+	return nil
+}
+
+func (r *fakeOutboxRepository) MarkEventDead(ctx context.Context, id int64, lastError string, at time.Time) error {
+	event := r.event(id)
+	event.Attempts++
+	event.LastError = lastError
+	event.DeadAt = &at
+	return nil
+}
+
+type fakeSink struct {
+	name          string
+	transactional bool
+	failures      int
+	calls         int
+	txCalls       int
+}
+
+func (s *fakeSink) Name() string {
+	return s.name
+}
+
+func (s *fakeSink) Transactional() bool {
+	return s.transactional
+}
+
+func (s *fakeSink) Deliver(ctx context.Context, event entity.Event) error {
+	s.calls++
+	if inFakeTx(ctx) {
+		s.txCalls++
+	}
+	if s.failures > 0 {
+		s.failures--
+		return errors.New("sink unavailable")
+	}
+	return nil
+}
+
+type fakeReassigner struct {
+	errs  map[string]error
+	calls []string
+}
+
+func (r *fakeReassigner) ReAssign(ctx context.Context, prId, oldReviewerId string) (*entity.PullRequest, error) {
+	r.calls = append(r.calls, prId)
+	if err := r.errs[prId]; err != nil {
+		return nil, err
+	}
+	return &entity.PullRequest{PullRequestID: prId}, nil
+}
+
+type fakeSLARepository struct {
+	PRRepository
+	pending    []entity.SLAReview
+	failRecord map[string]bool
+	overdue    []string
+	records    []entity.AssignmentRecord
+}
+
+func (r *fakeSLARepository) GetPendingSLAReviews(ctx context.Context) ([]entity.SLAReview, error) {
+	return r.pending, nil
+}
+
+func (r *fakeSLARepository) MarkReviewOverdue(ctx context.Context, prId, userId string, at time.Time) error {
+	if !inFakeTx(ctx) {
+		return errors.New("marked outside a transaction")
+	}
+	r.overdue = append(r.overdue, prId)
+	return nil
+}
+
+func (r *fakeSLARepository) AddAssignmentRecord(ctx context.Context, record *entity.AssignmentRecord) error {
+	if r.failRecord[record.PullRequestID] {
+		return errors.New("insert failed")
+	}
+	r.records = append(r.records, *record)
+	return nil
+}
+
+type fakeAvailabilityRepository struct {
+	AvailabilityRepository
+	windows []entity.Unavailability
+	marked  []int64
+}
+
+func (r *fakeAvailabilityRepository) GetStartedPendingReassignments(ctx context.Context, at time.Time) ([]entity.Unavailability, error) {
+	return r.windows, nil
+}
+
+func (r *fakeAvailabilityRepository) MarkReviewsReassigned(ctx context.Context, id int64, at time.Time) error {
+	r.marked = append(r.marked, id)
+	return nil
+}
+
+type fakeReviewRepository struct {
+	PRRepository
+	reviews map[string][]entity.PullRequestShort
+}
+
+func (r *fakeReviewRepository) GetAllPRForReviewer(ctx context.Context, userId string) ([]entity.PullRequestShort, error) {
+	return r.reviews[userId], nil
+}
@@ -0,0 +1,333 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"pullrequest-service/internal/entity"
+)
+
+type IntegrationUsecase struct {
+	accountRep AccountRepository
+	userRep    UserRepository
+	prs        PRLifecycle
+	resolvers  []AuthorResolver
+	logger     *slog.Logger
+}
+
+func NewIntegrationUsecase(accountRep AccountRepository, userRep UserRepository, prs PRLifecycle, resolvers []AuthorResolver,
+	logger *slog.Logger) *IntegrationUsecase {
+	return &IntegrationUsecase{accountRep: accountRep, userRep: userRep, prs: prs, resolvers: resolvers, logger: logger}
+}
+
+func (u *IntegrationUsecase) SetAccount(ctx context.Context, account *entity.ExternalAccount) (*entity.ExternalAccount, error) {
+	account.Login = entity.NormalizeLogin(account.Login)
+	u.logger.Info("start setting external account", "provider", account.Provider, "login", account.Login, "user_id", account.UserID)
+
+	if err := account.Validate(); err != nil {
+		u.logger.Warn("external account validation failed", "provider", account.Provider, "login", account.Login, "error", err)
+		return nil, err
+	}
+
+	if _, err := u.userRep.IsUserExist(ctx, account.UserID); err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			u.logger.Warn("user not found", "user_id", account.UserID)
+			return nil, err
+		}
+		u.logger.Error("failed to check user existence", "user_id", account.UserID, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	if err := u.accountRep.SetAccount(ctx, account); err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			u.logger.Warn("user not found", "user_id", account.UserID)
+			return nil, err
+		}
+		u.logger.Error("failed to set external account", "provider", account.Provider, "login", account.Login, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	u.logger.Info("external account set successfully", "provider", account.Provider, "login", account.Login, "user_id", account.UserID)
+
+	u.replay(ctx, account.Provider, account.Login)
+
+	return account, nil
+}
+
+func (u *IntegrationUsecase) ListAccounts(ctx context.Context, provider entity.Provider) ([]entity.ExternalAccount, error) {
+	u.logger.Info("start listing external accounts", "provider", provider)
+
+	if provider != "" {
+		if err := provider.Validate(); err != nil {
+			u.logger.Warn("invalid provider", "provider", provider)
+			return nil, err
+		}
+	}
+
+	accounts, err := u.accountRep.ListAccounts(ctx, provider)
+	if err != nil {
+		u.logger.Error("failed to list external accounts", "provider", provider, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	u.logger.Info("successfully listed external accounts", "provider", provider, "count", len(accounts))
+
+	return accounts, nil
+}
+
+func (u *IntegrationUsecase) DeleteAccount(ctx context.Context, provider entity.Provider, login string) error {
+	login = entity.NormalizeLogin(login)
+	u.logger.Info("start deleting external account", "provider", provider, "login", login)
+
+	if err := provider.Validate(); err != nil {
+		u.logger.Warn("invalid provider", "provider", provider)
+		return err
+	}
+
+	if login == "" {
+		u.logger.Warn("invalid login: empty", "provider", provider)
+		return entity.ErrInvalidRequest
+	}
+
+	if err := u.accountRep.DeleteAccount(ctx, provider, login); err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			u.logger.Warn("external account not found", "provider", provider, "login", login)
+			return err
+		}
+		u.logger.Error("failed to delete external account", "provider", provider, "login", login, "error", err)
+		return entity.ErrInternalError
+	}
+
+	u.logger.Info("external account deleted successfully", "provider", provider, "login", login)
+
+	return nil
+}
+
+func (u *IntegrationUsecase) ListUnmapped(ctx context.Context, provider entity.Provider) ([]entity.UnmappedEvent, error) {
+	u.logger.Info("start listing unmapped events", "provider", provider)
+
+	if provider != "" {
+		if err := provider.Validate(); err != nil {
+			u.logger.Warn("invalid provider", "provider", provider)
+			return nil, err
+		}
+	}
+
+	events, err := u.accountRep.ListUnmapped(ctx, provider, "")
+	if err != nil {
+		u.logger.Error("failed to list unmapped events", "provider", provider, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	u.logger.Info("successfully listed unmapped events", "provider", provider, "count", len(events))
+
+	return events, nil
+}
+
+func (u *IntegrationUsecase) DismissUnmapped(ctx context.Context, id int64) error {
+	u.logger.Info("start dismissing unmapped event", "id", id)
+
+	if id <= 0 {
+		u.logger.Warn("invalid unmapped event id", "id", id)
+		return entity.ErrInvalidRequest
+	}
+
+	if err := u.accountRep.DeleteUnmapped(ctx, id); err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			u.logger.Warn("unmapped event not found", "id", id)
+			return err
+		}
+		u.logger.Error("failed to dismiss unmapped event", "id", id, "error", err)
+		return entity.ErrInternalError
+	}
+
+	u.logger.Info("unmapped event dismissed successfully", "id", id)
+
+	return nil
+}
+
+func (u *IntegrationUsecase) HandlePullRequest(ctx context.Context, event *entity.ExternalPREvent) (*entity.IngestResult, error) {
+	u.logger.Info("start handling external PR event", "provider", event.Provider, "action", event.Action, "pull_request_id", event.PullRequestID)
+
+	if event.PullRequestID == "" {
+		u.logger.Warn("invalid external PR event: empty pull_request_id", "provider", event.Provider, "action", event.Action)
+		return nil, entity.ErrInvalidRequest
+	}
+
+	var result *entity.IngestResult
+	var err error
+
+	switch event.Action {
+	case entity.ExternalPROpened, entity.ExternalPRReady:
+		result, err = u.open(ctx, event)
+	case entity.ExternalPRReopened:
+		result, err = u.reopen(ctx, event)
+	case entity.ExternalPRClosed:
+		result, err = u.close(ctx, event)
+	case entity.ExternalPRDraft:
+		result = &entity.IngestResult{Status: entity.IngestIgnored, Reason: "pull request converted to draft"}
+	default:
+		result = &entity.IngestResult{Status: entity.IngestIgnored, Reason: fmt.Sprintf("unsupported action %q", event.Action)}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	u.logger.Info("external PR event handled", "provider", event.Provider, "action", event.Action, "pull_request_id", event.PullRequestID,
+		"status", result.Status, "reason", result.Reason)
+
+	return result, nil
+}
+
+func (u *IntegrationUsecase) open(ctx context.Context, event *entity.ExternalPREvent) (*entity.IngestResult, error) {
+	if event.Draft {
+		return &entity.IngestResult{Status: entity.IngestIgnored, Reason: "draft pull request"}, nil
+	}
+
+	if event.AuthorLogin == "" && event.AuthorID != "" {
+		resolved, err := u.resolveAuthor(ctx, event)
+		if err != nil || resolved != nil {
+			return resolved, err
+		}
+	}
+
+	login := entity.NormalizeLogin(event.AuthorLogin)
+	if login == "" {
+		u.logger.Warn("external PR event without author login", "provider", event.Provider, "pull_request_id", event.PullRequestID)
+		return nil, entity.ErrInvalidRequest
+	}
+
+	authorId, err := u.accountRep.GetUserIdByLogin(ctx, event.Provider, login)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return u.queue(ctx, login, event)
+		}
+		u.logger.Error("failed to resolve external login", "provider", event.Provider, "login", login, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	req := &entity.CreatePRRequest{PullRequestID: event.PullRequestID, PullRequestName: event.Title, AuthorID: authorId}
+
+	pr, err := u.prs.CreatePR(ctx, req)
+	if err != nil {
+		if errors.Is(err, entity.ErrPRExists) {
+			return &entity.IngestResult{Status: entity.IngestIgnored, Reason: "pull request already exists"}, nil
+		}
+		return nil, err
+	}
+
+	return &entity.IngestResult{Status: entity.IngestApplied, PullRequest: pr}, nil
+}
+
+// resolveAuthor fills in the author login of an event that carries only the
+// code host's user id. It returns a result when the event cannot be applied.
+func (u *IntegrationUsecase) resolveAuthor(ctx context.Context, event *entity.ExternalPREvent) (*entity.IngestResult, error) {
+	for _, resolver := range u.resolvers {
+		if resolver.Provider() != event.Provider {
+			continue
+		}
+
+		login, err := resolver.Username(ctx, event.AuthorID)
+		if err != nil {
+			if errors.Is(err, entity.ErrCodeHostRejected) {
+				u.logger.Warn("external PR author not found", "provider", event.Provider, "author_id", event.AuthorID, "error", err)
+				return &entity.IngestResult{Status: entity.IngestIgnored, Reason: fmt.Sprintf("%s user %s not found", event.Provider, event.AuthorID)}, nil
+			}
+			u.logger.Error("failed to resolve external PR author", "provider", event.Provider, "author_id", event.AuthorID, "error", err)
+			return nil, entity.ErrInternalError
+		}
+
+		event.AuthorLogin = login
+		return nil, nil
+	}
+
+	u.logger.Warn("no API client to resolve external PR author", "provider", event.Provider, "author_id", event.AuthorID,
+		"pull_request_id", event.PullRequestID)
+
+	return &entity.IngestResult{Status: entity.IngestIgnored,
+		Reason: fmt.Sprintf("%s author %s cannot be resolved without an API client", event.Provider, event.AuthorID)}, nil
+}
+
+func (u *IntegrationUsecase) reopen(ctx context.Context, event *entity.ExternalPREvent) (*entity.IngestResult, error) {
+	if event.Draft {
+		return &entity.IngestResult{Status: entity.IngestIgnored, Reason: "draft pull request"}, nil
+	}
+
+	pr, err := u.prs.ReopenPR(ctx, event.PullRequestID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return u.open(ctx, event)
+		}
+		return nil, err
+	}
+
+	return &entity.IngestResult{Status: entity.IngestApplied, PullRequest: pr}, nil
+}
+
+func (u *IntegrationUsecase) close(ctx context.Context, event *entity.ExternalPREvent) (*entity.IngestResult, error) {
+	apply := u.prs.ClosePR
+	if event.Merged {
+		apply = u.prs.MergePR
+	}
+
+	pr, err := apply(ctx, event.PullRequestID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return u.queueBehindUnmapped(ctx, event)
+		}
+		return nil, err
+	}
+
+	return &entity.IngestResult{Status: entity.IngestApplied, PullRequest: pr}, nil
+}
+
+func (u *IntegrationUsecase) queueBehindUnmapped(ctx context.Context, event *entity.ExternalPREvent) (*entity.IngestResult, error) {
+	login, err := u.accountRep.GetUnmappedLoginByPR(ctx, event.Provider, event.PullRequestID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return &entity.IngestResult{Status: entity.IngestIgnored, Reason: "pull request is not tracked"}, nil
+		}
+		u.logger.Error("failed to check unmapped events", "provider", event.Provider, "pull_request_id", event.PullRequestID, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	return u.queue(ctx, login, event)
+}
+
+func (u *IntegrationUsecase) queue(ctx context.Context, login string, event *entity.ExternalPREvent) (*entity.IngestResult, error) {
+	if err := u.accountRep.QueueUnmapped(ctx, login, event); err != nil {
+		u.logger.Error("failed to queue unmapped event", "provider", event.Provider, "login", login, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	u.logger.Warn("external login is not mapped, event queued for review", "provider", event.Provider, "login", login,
+		"pull_request_id", event.PullRequestID, "action", event.Action)
+
+	return &entity.IngestResult{Status: entity.IngestQueued, Reason: fmt.Sprintf("%s login %q is not mapped to a user", event.Provider, login)}, nil
+}
+
+func (u *IntegrationUsecase) replay(ctx context.Context, provider entity.Provider, login string) {
+	pending, err := u.accountRep.ListUnmapped(ctx, provider, login)
+	if err != nil {
+		u.logger.Error("failed to list unmapped events", "provider", provider, "login", login, "error", err)
+		return
+	}
+
+	for _, queued := range pending {
+		if _, err := u.HandlePullRequest(ctx, &queued.Event); err != nil {
+			u.logger.Warn("failed to replay unmapped event, leaving the rest queued", "id", queued.ID, "provider", provider, "login", login, "error", err)
+			return
+		}
+
+		if err := u.accountRep.DeleteUnmapped(ctx, queued.ID); err != nil {
+			u.logger.Error("failed to delete replayed event", "id", queued.ID, "error", err)
+			return
+		}
+	}
+
+	if len(pending) > 0 {
+		u.logger.Info("replayed unmapped events", "provider", provider, "login", login, "count", len(pending))
+	}
+}
@@ -0,0 +1,149 @@
+package usecase
+
+import (
+	"context"
+	"pullrequest-service/internal/entity"
+	"slices"
+	"testing"
+)
+
+func TestHandlePullRequestDraft(t *testing.T) {
+	const prId = "platform/billing!17"
+
+	tests := []struct {
+		name       string
+		status     entity.Status
+		tracked    bool
+		actions    []entity.ExternalPRAction
+		wantStatus entity.Status
+		wantCalls  []string
+	}{
+		{
+			name:       "converting to draft keeps an open PR open",
+			status:     entity.OPEN,
+			tracked:    true,
+			actions:    []entity.ExternalPRAction{entity.ExternalPRDraft, entity.ExternalPRReady},
+			wantStatus: entity.OPEN,
+			wantCalls:  []string{"create"},
+		},
+		{
+			name:       "ready does not reopen a closed PR",
+			status:     entity.CLOSED,
+			tracked:    true,
+			actions:    []entity.ExternalPRAction{entity.ExternalPRReady},
+			wantStatus: entity.CLOSED,
+			wantCalls:  []string{"create"},
+		},
+		{
+			name:       "ready creates a PR opened as a draft",
+			actions:    []entity.ExternalPRAction{entity.ExternalPRReady},
+			wantStatus: entity.OPEN,
+			wantCalls:  []string{"create"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prs := &fakePRLifecycle{prs: make(map[string]*entity.PullRequest)}
+			if tt.tracked {
+				prs.prs[prId] = &entity.PullRequest{PullRequestID: prId, AuthorID: "u1", Status: tt.status}
+			}
+
+			u := NewIntegrationUsecase(&fakeAccountRepository{logins: map[string]string{"u1": "jane.doe"}}, nil, prs, nil, discardLogger())
+
+			for _, action := range tt.actions {
+				event := &entity.ExternalPREvent{Provider: entity.ProviderGitLab, Action: action, PullRequestID: prId, AuthorLogin: "jane.doe"}
+				if _, err := u.HandlePullRequest(context.Background(), event); err != nil {
+					t.Fatalf("HandlePullRequest(%s) error = %v", action, err)
+				}
+			}
+
+			pr, ok := prs.prs[prId]
+			if !ok || pr.Status != tt.wantStatus {
+				t.Errorf("PR = %+v, want status %s", pr, tt.wantStatus)
+			}
+
+			if !slices.Equal(prs.calls, tt.wantCalls) {
+				t.Errorf("calls = %v, want %v", prs.calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestHandlePullRequestAuthor(t *testing.T) {
+	const prId = "platform/billing!17"
+
+	tests := []struct {
+		name       string
+		action     entity.ExternalPRAction
+		login      string
+		resolvers  []AuthorResolver
+		wantStatus entity.IngestStatus
+		wantAuthor string
+	}{
+		{
+			name:       "open uses the login from the payload",
+			action:     entity.ExternalPROpened,
+			login:      "jane.doe",
+			wantStatus: entity.IngestApplied,
+			wantAuthor: "u1",
+		},
+		{
+			name:       "ready resolves the author id, not the actor",
+			action:     entity.ExternalPRReady,
+			resolvers:  []AuthorResolver{&fakeAuthorResolver{usernames: map[string]string{"58": "jane.doe"}}},
+			wantStatus: entity.IngestApplied,
+			wantAuthor: "u1",
+		},
+		{
+			name:       "reopen of an unknown PR resolves the author id",
+			action:     entity.ExternalPRReopened,
+			resolvers:  []AuthorResolver{&fakeAuthorResolver{usernames: map[string]string{"58": "jane.doe"}}},
+			wantStatus: entity.IngestApplied,
+			wantAuthor: "u1",
+		},
+		{
+			name:       "unknown author id is ignored",
+			action:     entity.ExternalPRReady,
+			resolvers:  []AuthorResolver{&fakeAuthorResolver{}},
+			wantStatus: entity.IngestIgnored,
+		},
+		{
+			name:       "no client to resolve the author",
+			action:     entity.ExternalPRReady,
+			wantStatus: entity.IngestIgnored,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prs := &fakePRLifecycle{prs: make(map[string]*entity.PullRequest)}
+			accounts := &fakeAccountRepository{logins: map[string]string{"u1": "jane.doe", "u2": "john.smith"}}
+			u := NewIntegrationUsecase(accounts, nil, prs, tt.resolvers, discardLogger())
+
+			event := &entity.ExternalPREvent{Provider: entity.ProviderGitLab, Action: tt.action, PullRequestID: prId, AuthorLogin: tt.login,
+				AuthorID: "58"}
+
+			result, err := u.HandlePullRequest(context.Background(), event)
+			if err != nil {
+				t.Fatalf("HandlePullRequest() error = %v", err)
+			}
+
+			if result.Status != tt.wantStatus {
+				t.Fatalf("status = %s (%s), want %s", result.Status, result.Reason, tt.wantStatus)
+			}
+
+			pr, ok := prs.prs[prId]
+			if tt.wantAuthor == "" {
+				if ok {
+					t.Errorf("PR created with author %s, want none", pr.AuthorID)
+				}
+				return
+			}
+
+			if !ok || pr.AuthorID != tt.wantAuthor {
+				t.Errorf("PR = %+v, want author %s", pr, tt.wantAuthor)
+			}
+		})
+	}
+}
@@ -0,0 +1,305 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"pullrequest-service/internal/entity"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	notificationBatchSize = 50
+	digestBatchSize       = 500
+	notificationLease     = 5 * time.Minute
+)
+
+type NotificationUsecase struct {
+	notificationRep NotificationRepository
+	userRep         UserRepository
+	senders         map[entity.ChatKind]ChatSender
+	retry           entity.RetryPolicy
+	logger          *slog.Logger
+}
+
+func NewNotificationUsecase(notificationRep NotificationRepository, userRep UserRepository, senders []ChatSender, retry entity.RetryPolicy,
+	logger *slog.Logger) *NotificationUsecase {
+	byKind := make(map[entity.ChatKind]ChatSender, len(senders))
+	for _, sender := range senders {
+		byKind[sender.Kind()] = sender
+	}
+	return &NotificationUsecase{notificationRep: notificationRep, userRep: userRep, senders: byKind, retry: retry, logger: logger}
+}
+
+func (u *NotificationUsecase) SetPreferences(ctx context.Context, prefs *entity.NotificationPreferences) (*entity.NotificationPreferences, error) {
+	u.logger.Info("start setting notification preferences", "user_id", prefs.UserID)
+
+	if err := prefs.Validate(); err != nil {
+		u.logger.Warn("notification preferences validation failed", "user_id", prefs.UserID, "error", err)
+		return nil, err
+	}
+
+	if err := u.notificationRep.SetPreferences(ctx, prefs); err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			u.logger.Warn("user not found", "user_id", prefs.UserID)
+			return nil, err
+		}
+		u.logger.Error("failed to set notification preferences", "user_id", prefs.UserID, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	u.logger.Info("notification preferences set successfully", "user_id", prefs.UserID)
+
+	return prefs, nil
+}
+
+func (u *NotificationUsecase) GetPreferences(ctx context.Context, userId string) (*entity.NotificationPreferences, error) {
+	u.logger.Info("start getting notification preferences", "user_id", userId)
+
+	if userId == "" {
+		u.logger.Warn("invalid user_id: empty")
+		return nil, entity.ErrInvalidRequest
+	}
+
+	if _, err := u.userRep.IsUserExist(ctx, userId); err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			u.logger.Warn("user not found", "user_id", userId)
+			return nil, err
+		}
+		u.logger.Error("failed to check user existence", "user_id", userId, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	prefs, err := u.preferences(ctx, userId)
+	if err != nil {
+		u.logger.Error("failed to get notification preferences", "user_id", userId, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	u.logger.Info("successfully got notification preferences", "user_id", userId)
+
+	return prefs, nil
+}
+
+func (u *NotificationUsecase) Name() string {
+	return "notifications"
+}
+
+func (u *NotificationUsecase) Transactional() bool {
+	return true
+}
+
+func (u *NotificationUsecase) Deliver(ctx context.Context, event entity.Event) error {
+	if event.PullRequest == nil {
+		return nil
+	}
+
+	for _, n := range notificationsFor(&event) {
+		prefs, err := u.preferences(ctx, n.UserID)
+		if err != nil {
+			return err
+		}
+
+		if prefs.Mute {
+			continue
+		}
+
+		n.Digest = prefs.DigestOnly
+		if err := u.notificationRep.CreateNotification(ctx, n); err != nil {
+			if errors.Is(err, entity.ErrNotFound) {
+				continue
+			}
+			return err
+		}
+
+		u.logger.Info("notification scheduled", "event_id", event.ID, "user_id", n.UserID, "kind", n.Kind, "digest", n.Digest)
+	}
+
+	return nil
+}
+
+func notificationsFor(event *entity.Event) []*entity.Notification {
+	pr := event.PullRequest
+	title := fmt.Sprintf("%s (%s)", pr.PullRequestName, pr.PullRequestID)
+
+	newNotification := func(userId string, kind entity.NotificationKind, text string) *entity.Notification {
+		return &entity.Notification{UserID: userId, EventID: event.ID, Kind: kind, PullRequestID: pr.PullRequestID, Text: text,
+			NextAttemptAt: event.OccurredAt}
+	}
+
+	var notifications []*entity.Notification
+
+	switch event.Type {
+	case entity.EventReviewerAssigned, entity.EventReviewerReassigned:
+		for _, userId := range event.ReviewerIDs {
+			notifications = append(notifications, newNotification(userId, entity.NotificationAssigned,
+				fmt.Sprintf("You were assigned to review %s", title)))
+		}
+		if event.OldReviewerID != "" {
+			notifications = append(notifications, newNotification(event.OldReviewerID, entity.NotificationUnassigned,
+				fmt.Sprintf("You were reassigned away from %s", title)))
+		}
+	case entity.EventPRMerged:
+		for _, userId := range pr.AssignedReviewers {
+			notifications = append(notifications, newNotification(userId, entity.NotificationMerged,
+				fmt.Sprintf("%s you reviewed was merged", title)))
+		}
+	}
+
+	return notifications
+}
+
+func (u *NotificationUsecase) preferences(ctx context.Context, userId string) (*entity.NotificationPreferences, error) {
+	prefs, err := u.notificationRep.GetPreferences(ctx, userId)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return entity.DefaultNotificationPreferences(userId), nil
+		}
+		return nil, err
+	}
+	return prefs, nil
+}
+
+func (u *NotificationUsecase) SendPending(ctx context.Context) error {
+	notifications, err := u.notificationRep.ClaimDueNotifications(ctx, false, time.Now(), notificationLease, notificationBatchSize)
+	if err != nil {
+		u.logger.Error("failed to claim notifications", "error", err)
+		return entity.ErrInternalError
+	}
+
+	for i := range notifications {
+		n := &notifications[i]
+		if err := u.send(ctx, n.UserID, []*entity.Notification{n}, notificationText); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (u *NotificationUsecase) SendDigests(ctx context.Context) error {
+	notifications, err := u.notificationRep.ClaimDueNotifications(ctx, true, time.Now(), notificationLease, digestBatchSize)
+	if err != nil {
+		u.logger.Error("failed to claim digest notifications", "error", err)
+		return entity.ErrInternalError
+	}
+
+	var userIds []string
+	byUser := make(map[string][]*entity.Notification)
+	for i := range notifications {
+		n := &notifications[i]
+		if _, ok := byUser[n.UserID]; !ok {
+			userIds = append(userIds, n.UserID)
+		}
+		byUser[n.UserID] = append(byUser[n.UserID], n)
+	}
+
+	for _, userId := range userIds {
+		if err := u.send(ctx, userId, byUser[userId], digestText); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func notificationText(group []*entity.Notification) string {
+	return group[0].Text
+}
+
+func digestText(group []*entity.Notification) string {
+	lines := make([]string, len(group))
+	for i, n := range group {
+		lines[i] = "• " + n.Text
+	}
+	return fmt.Sprintf("Review digest: %d update(s)\n%s", len(group), strings.Join(lines, "\n"))
+}
+
+// send delivers the group to every channel of the user. A channel that took a
+// notification is recorded on it, so a retry after a partial failure only
+// goes to the channels that failed.
+func (u *NotificationUsecase) send(ctx context.Context, userId string, group []*entity.Notification, render func([]*entity.Notification) string) error {
+	prefs, err := u.preferences(ctx, userId)
+	if err != nil {
+		u.logger.Error("failed to get notification preferences", "user_id", userId, "error", err)
+		return entity.ErrInternalError
+	}
+
+	now := time.Now()
+	failures := make(map[*entity.Notification]error)
+	var sendErr error
+	sent := 0
+
+	if !prefs.Mute {
+		for _, channel := range prefs.Channels {
+			sender, ok := u.senders[channel.Kind]
+			if !ok {
+				continue
+			}
+
+			key := channel.Key()
+			pending := make([]*entity.Notification, 0, len(group))
+			for _, n := range group {
+				if !slices.Contains(n.SentChannels, key) {
+					pending = append(pending, n)
+				}
+			}
+
+			if len(pending) == 0 {
+				continue
+			}
+
+			if err := sender.Send(ctx, channel.Channel, render(pending)); err != nil {
+				sendErr = errors.Join(sendErr, err)
+				for _, n := range pending {
+					failures[n] = errors.Join(failures[n], err)
+				}
+				continue
+			}
+
+			for _, n := range pending {
+				n.SentChannels = append(n.SentChannels, key)
+			}
+			sent++
+		}
+	}
+
+	for _, n := range group {
+		n.Attempts++
+
+		failure := failures[n]
+
+		switch {
+		case failure == nil:
+			n.Status = entity.DeliveryDelivered
+			n.SentAt = &now
+			n.LastError = ""
+		case n.Attempts >= u.retry.MaxAttempts:
+			n.Status = entity.DeliveryFailed
+			n.LastError = failure.Error()
+		default:
+			n.LastError = failure.Error()
+			n.NextAttemptAt = now.Add(u.retry.Delay(n.Attempts))
+		}
+
+		if err := u.notificationRep.UpdateNotification(ctx, n); err != nil {
+			u.logger.Error("failed to update notification", "id", n.ID, "error", err)
+			return entity.ErrInternalError
+		}
+	}
+
+	switch {
+	case sendErr != nil:
+		u.logger.Warn("failed to send notification", "user_id", userId, "notifications", len(group), "attempts", group[0].Attempts,
+			"status", group[0].Status, "channels", sent, "error", sendErr)
+	case sent == 0:
+		u.logger.Info("notification dropped: no chat channel configured", "user_id", userId, "notifications", len(group))
+	default:
+		u.logger.Info("notification sent", "user_id", userId, "notifications", len(group), "channels", sent)
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"pullrequest-service/internal/chat"
+	"pullrequest-service/internal/entity"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type chatReceiver struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	failures int
+	messages []string
+}
+
+func newChatReceiver() *chatReceiver {
+	r := &chatReceiver{}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var msg struct {
+			Text string `json:"text"`
+		}
+		_ = json.NewDecoder(req.Body).Decode(&msg)
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		if r.failures > 0 {
+			r.failures--
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		r.messages = append(r.messages, msg.Text)
+	}))
+	return r
+}
+
+func (r *chatReceiver) received() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.messages)
+}
+
+func newTestNotifications(repo *fakeNotificationRepository, slack, mattermost *chatReceiver) *NotificationUsecase {
+	senders := []ChatSender{chat.NewSlackSender(slack.URL, time.Second), chat.NewMattermostSender(mattermost.URL, time.Second)}
+	retry := entity.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Second, MaxDelay: time.Minute}
+	return NewNotificationUsecase(repo, nil, senders, retry, discardLogger())
+}
+
+func assignedEvent(reviewerIds ...string) entity.Event {
+	event := entity.NewPREvent(entity.EventReviewerAssigned, &entity.PullRequest{PullRequestID: "pr-1", PullRequestName: "Add search"})
+	event.ReviewerIDs = reviewerIds
+	return event
+}
+
+func TestNotificationDeliverPreferences(t *testing.T) {
+	repo := &fakeNotificationRepository{prefs: map[string]*entity.NotificationPreferences{
+		"muted":  {UserID: "muted", Mute: true},
+		"digest": {UserID: "digest", DigestOnly: true, Channels: []entity.ChatChannel{{Kind: entity.ChatSlack}}},
+	}}
+
+	slack, mattermost := newChatReceiver(), newChatReceiver()
+	defer slack.Close()
+	defer mattermost.Close()
+
+	u := newTestNotifications(repo, slack, mattermost)
+
+	if err := u.Deliver(context.Background(), assignedEvent("muted", "digest", "default")); err != nil {
+		t.Fatalf("Deliver() error = %v", err)
+	}
+
+	created := make(map[string]bool)
+	for _, n := range repo.notifications {
+		created[n.UserID] = n.Digest
+	}
+
+	if _, ok := created["muted"]; ok {
+		t.Error("notification created for a muted user")
+	}
+
+	if digest, ok := created["digest"]; !ok || !digest {
+		t.Errorf("digest-only user: created = %v, digest = %v, want a digest notification", ok, digest)
+	}
+
+	if digest, ok := created["default"]; !ok || digest {
+		t.Errorf("default user: created = %v, digest = %v, want an immediate notification", ok, digest)
+	}
+
+	if err := u.SendPending(context.Background()); err != nil {
+		t.Fatalf("SendPending() error = %v", err)
+	}
+
+	if got := len(slack.received()); got != 1 {
+		t.Errorf("slack got %d messages, want 1: digest-only notifications wait for the digest", got)
+	}
+
+	if err := u.Deliver(context.Background(), assignedEvent("digest")); err != nil {
+		t.Fatalf("Deliver() error = %v", err)
+	}
+
+	if err := u.SendDigests(context.Background()); err != nil {
+		t.Fatalf("SendDigests() error = %v", err)
+	}
+
+	messages := slack.received()
+	if len(messages) != 2 || !strings.HasPrefix(messages[1], "Review digest: 2 update(s)") {
+		t.Errorf("slack messages = %q, want one digest with 2 updates", messages)
+	}
+
+	if got := len(mattermost.received()); got != 1 {
+		t.Errorf("mattermost got %d messages, want 1 for the default user only", got)
+	}
+}
+
+func TestNotificationSendPendingRetriesFailedChannelOnly(t *testing.T) {
+	repo := &fakeNotificationRepository{}
+
+	slack, mattermost := newChatReceiver(), newChatReceiver()
+	defer slack.Close()
+	defer mattermost.Close()
+
+	slack.failures = 1
+
+	u := newTestNotifications(repo, slack, mattermost)
+
+	if err := u.Deliver(context.Background(), assignedEvent("u1")); err != nil {
+		t.Fatalf("Deliver() error = %v", err)
+	}
+
+	if err := u.SendPending(context.Background()); err != nil {
+		t.Fatalf("SendPending() error = %v", err)
+	}
+
+	n := repo.notifications[0]
+	if n.Status != entity.DeliveryPending || !slices.Equal(n.SentChannels, []string{"mattermost"}) {
+		t.Fatalf("after a partial failure: status = %s, sent channels = %v", n.Status, n.SentChannels)
+	}
+
+	if err := u.SendPending(context.Background()); err != nil {
+		t.Fatalf("SendPending() error = %v", err)
+	}
+
+	n = repo.notifications[0]
+	if n.Status != entity.DeliveryDelivered || n.Attempts != 2 {
+		t.Errorf("after the retry: status = %s, attempts = %d", n.Status, n.Attempts)
+	}
+
+	if got := len(slack.received()); got != 1 {
+		t.Errorf("slack got %d messages, want 1", got)
+	}
+
+	if got := len(mattermost.received()); got != 1 {
+		t.Errorf("mattermost got %d messages, want 1: the retry must not duplicate", got)
+	}
+}
@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"pullrequest-service/internal/entity"
+	"slices"
+	"time"
+)
+
+const outboxBatchSize = 100
+
+type OutboxUsecase struct {
+	outboxRep OutboxRepository
+	sinks     []EventSink
+	retry     entity.RetryPolicy
+	txMgr     TxManager
+	logger    *slog.Logger
+}
+
+func NewOutboxUsecase(outboxRep OutboxRepository, sinks []EventSink, retry entity.RetryPolicy, txMgr TxManager, logger *slog.Logger) *OutboxUsecase {
+	return &OutboxUsecase{outboxRep: outboxRep, sinks: sinks, retry: retry, txMgr: txMgr, logger: logger}
+}
+
+func (u *OutboxUsecase) Publish(ctx context.Context, event entity.Event) error {
+	return u.outboxRep.AddEvent(ctx, event)
+}
+
+// Relay delivers due events to the sinks they have not reached yet. Each
+// transactional sink writes in one transaction with its delivery record; the
+// other sinks are called outside any transaction, so a slow code host holds
+// only the relay lock.
+func (u *OutboxUsecase) Relay(ctx context.Context) error {
+	unlock, locked, err := u.outboxRep.TryLockRelay(ctx)
+	if err != nil {
+		u.logger.Error("failed to lock outbox relay", "error", err)
+		return entity.ErrInternalError
+	}
+
+	if !locked {
+		return nil
+	}
+	defer unlock()
+
+	for relayed := 0; relayed < outboxBatchSize; {
+		pending, err := u.outboxRep.GetPendingEvents(ctx, time.Now(), uint64(outboxBatchSize-relayed))
+		if err != nil {
+			u.logger.Error("failed to get pending outbox events", "error", err)
+			return entity.ErrInternalError
+		}
+
+		settled := 0
+
+		for i := range pending {
+			done, err := u.relay(ctx, &pending[i])
+			if err != nil {
+				return err
+			}
+			if done {
+				settled++
+			}
+		}
+
+		if settled == 0 {
+			break
+		}
+
+		relayed += len(pending)
+	}
+
+	return nil
+}
+
+func (u *OutboxUsecase) relay(ctx context.Context, pendingEvent *entity.OutboxEvent) (bool, error) {
+	event := pendingEvent.Event
+	attempts := pendingEvent.Attempts + 1
+
+	dispatchErr := u.dispatch(ctx, pendingEvent)
+
+	switch {
+	case dispatchErr == nil:
+		if err := u.outboxRep.MarkEventProcessed(ctx, pendingEvent.ID, time.Now()); err != nil {
+			u.logger.Error("failed to mark outbox event processed", "id", pendingEvent.ID, "error", err)
+			return false, entity.ErrInternalError
+		}
+		return true, nil
+	case attempts >= u.retry.MaxAttempts:
+		u.logger.Error("failed to relay event, giving up", "event_id", event.ID, "type", event.Type, "ordering_key", event.OrderingKey(),
+			"attempts", attempts, "error", dispatchErr)
+
+		if err := u.outboxRep.MarkEventDead(ctx, pendingEvent.ID, dispatchErr.Error(), time.Now()); err != nil {
+			u.logger.Error("failed to mark outbox event dead", "id", pendingEvent.ID, "error", err)
+			return false, entity.ErrInternalError
+		}
+		return true, nil
+	default:
+		nextAttemptAt := time.Now().Add(u.retry.Delay(attempts))
+
+		u.logger.Warn("failed to relay event, holding back later events for the same key", "event_id", event.ID, "type", event.Type,
+			"ordering_key", event.OrderingKey(), "attempts", attempts, "next_attempt_at", nextAttemptAt, "error", dispatchErr)
+
+		if err := u.outboxRep.MarkEventFailed(ctx, pendingEvent.ID, dispatchErr.Error(), nextAttemptAt); err != nil {
+			u.logger.Error("failed to mark outbox event failed", "id", pendingEvent.ID, "error", err)
+			return false, entity.ErrInternalError
+		}
+		return false, nil
+	}
+}
+
+// dispatch delivers the event to every sink that has not received it yet and
+// records each delivery, so a failing sink is retried alone.
+func (u *OutboxUsecase) dispatch(ctx context.Context, pendingEvent *entity.OutboxEvent) error {
+	var errs []error
+
+	for _, sink := range u.sinks {
+		if slices.Contains(pendingEvent.DeliveredSinks, sink.Name()) {
+			continue
+		}
+
+		if err := u.deliver(ctx, pendingEvent, sink); err != nil {
+			errs = append(errs, fmt.Errorf("%s sink: %w", sink.Name(), err))
+			continue
+		}
+
+		pendingEvent.DeliveredSinks = append(pendingEvent.DeliveredSinks, sink.Name())
+	}
+
+	return errors.Join(errs...)
+}
+
+func (u *OutboxUsecase) deliver(ctx context.Context, pendingEvent *entity.OutboxEvent, sink EventSink) error {
+	if sink.Transactional() {
+		return u.txMgr.WithTx(ctx, func(ctx context.Context) error {
+			if err := sink.Deliver(ctx, pendingEvent.Event); err != nil {
+				return err
+			}
+			return u.outboxRep.MarkSinkDelivered(ctx, pendingEvent.ID, sink.Name())
+		})
+	}
+
+	if err := sink.Deliver(ctx, pendingEvent.Event); err != nil {
+		return err
+	}
+
+	// The sink has already been called, so a failed record is only logged:
+	// the sink gets the event again only if another sink fails.
+	if err := u.outboxRep.MarkSinkDelivered(ctx, pendingEvent.ID, sink.Name()); err != nil {
+		u.logger.Error("failed to mark outbox sink delivered", "id", pendingEvent.ID, "sink", sink.Name(), "error", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"pullrequest-service/internal/entity"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestOutboxRelay(t *testing.T) {
+	tests := []struct {
+		name              string
+		maxAttempts       int
+		reviewerFails     int
+		relays            int
+		wantProcessed     bool
+		wantDead          bool
+		wantDelivered     []string
+		wantWebhookCalls  int
+		wantReviewerCalls int
+	}{
+		{
+			name:              "all sinks delivered",
+			maxAttempts:       3,
+			relays:            1,
+			wantProcessed:     true,
+			wantDelivered:     []string{"webhook", "reviewers"},
+			wantWebhookCalls:  1,
+			wantReviewerCalls: 1,
+		},
+		{
+			name:              "failing sink is retried alone",
+			maxAttempts:       3,
+			reviewerFails:     1,
+			relays:            2,
+			wantProcessed:     true,
+			wantDelivered:     []string{"webhook", "reviewers"},
+			wantWebhookCalls:  1,
+			wantReviewerCalls: 2,
+		},
+		{
+			name:              "dead event keeps the sinks that got it",
+			maxAttempts:       2,
+			reviewerFails:     2,
+			relays:            2,
+			wantDead:          true,
+			wantDelivered:     []string{"webhook"},
+			wantWebhookCalls:  1,
+			wantReviewerCalls: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := &entity.OutboxEvent{ID: 1, Event: entity.NewEvent(entity.EventReviewerAssigned)}
+			repo := &fakeOutboxRepository{events: []*entity.OutboxEvent{event}}
+
+			webhooks := &fakeSink{name: "webhook", transactional: true}
+			reviewers := &fakeSink{name: "reviewers", failures: tt.reviewerFails}
+
+			retry := entity.RetryPolicy{MaxAttempts: tt.maxAttempts, BaseDelay: time.Minute, MaxDelay: time.Hour}
+			u := NewOutboxUsecase(repo, []EventSink{webhooks, reviewers}, retry, fakeTxManager{}, discardLogger())
+
+			for range tt.relays {
+				event.NextAttemptAt = time.Time{}
+				if err := u.Relay(context.Background()); err != nil {
+					t.Fatalf("Relay() error = %v", err)
+				}
+			}
+
+			if got := event.ProcessedAt != nil; got != tt.wantProcessed {
+				t.Errorf("processed = %v, want %v", got, tt.wantProcessed)
+			}
+
+			if got := event.DeadAt != nil; got != tt.wantDead {
+				t.Errorf("dead = %v, want %v", got, tt.wantDead)
+			}
+
+			if !slices.Equal(event.DeliveredSinks, tt.wantDelivered) {
+				t.Errorf("delivered sinks = %v, want %v", event.DeliveredSinks, tt.wantDelivered)
+			}
+
+			if webhooks.calls != tt.wantWebhookCalls || reviewers.calls != tt.wantReviewerCalls {
+				t.Errorf("calls = webhook %d, reviewers %d, want %d and %d", webhooks.calls, reviewers.calls, tt.wantWebhookCalls, tt.wantReviewerCalls)
+			}
+
+			if webhooks.txCalls != webhooks.calls {
+				t.Errorf("transactional sink called outside a transaction")
+			}
+
+			if reviewers.txCalls != 0 {
+				t.Errorf("reviewers sink called inside a transaction")
+			}
+		})
+	}
+}
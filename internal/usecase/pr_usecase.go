@@ -3,21 +3,29 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
-	"math/rand"
 	"pullrequest-service/internal/entity"
+	"slices"
+	"strings"
+	"time"
 )
 
+const requiredReviewers = 2
+
 type PRUsecase struct {
-	prRep   PRRepository
-	userRep UserRepository
-	teamRep TeamRepository
-	txMgr   TxManager
-	logger  *slog.Logger
+	prRep    PRRepository
+	userRep  UserRepository
+	teamRep  TeamRepository
+	selector *ReviewerSelector
+	txMgr    TxManager
+	events   EventPublisher
+	logger   *slog.Logger
 }
 
-func NewPRUsecase(prRep PRRepository, userRep UserRepository, teamRep TeamRepository, txMgr TxManager, logger *slog.Logger) *PRUsecase {
-	return &PRUsecase{prRep: prRep, userRep: userRep, teamRep: teamRep, txMgr: txMgr, logger: logger}
+func NewPRUsecase(prRep PRRepository, userRep UserRepository, teamRep TeamRepository, selector *ReviewerSelector, txMgr TxManager, events EventPublisher,
+	logger *slog.Logger) *PRUsecase {
+	return &PRUsecase{prRep: prRep, userRep: userRep, teamRep: teamRep, selector: selector, txMgr: txMgr, events: events, logger: logger}
 }
 
 func (u *PRUsecase) MergePR(ctx context.Context, prId string) (*entity.PullRequest, error) {
@@ -28,118 +36,173 @@ func (u *PRUsecase) MergePR(ctx context.Context, prId string) (*entity.PullReque
 		return nil, entity.ErrInvalidRequest
 	}
 
-	open, err := u.prRep.IsPROpen(ctx, prId)
+	var pr *entity.PullRequest
 
-	if err != nil {
-		if errors.Is(err, entity.ErrNotFound) {
-			u.logger.Warn("PR not found", "pull_request_id", prId, "error", err)
-			return nil, err
+	operation := func(ctx context.Context) error {
+		open, err := u.prRep.IsPROpen(ctx, prId)
+
+		if err != nil {
+			if errors.Is(err, entity.ErrNotFound) {
+				u.logger.Warn("PR not found", "pull_request_id", prId, "error", err)
+				return err
+			}
+
+			u.logger.Error("failed to check PR status", "pull_request_id", prId, "error", err)
+
+			return entity.ErrInternalError
 		}
 
-		u.logger.Error("failed to check PR status", "pull_request_id", prId, "error", err)
+		if open {
+			err = u.prRep.MergePR(ctx, prId)
+			if err != nil {
+				u.logger.Error("failed to merge PR", "pull_request_id", prId, "error", err)
+				return entity.ErrInternalError
+			}
+		} else {
+			u.logger.Info("PR is not OPEN, skipping merge", "pull_request_id", prId)
+		}
 
-		return nil, entity.ErrInternalError
-	}
+		pr, err = u.prRep.GetPRById(ctx, prId)
+		if err != nil {
+			u.logger.Error("failed to get PR", "pull_request_id", prId, "error", err)
+			return entity.ErrInternalError
+		}
 
-	if open {
-		err = u.prRep.MergePR(ctx, prId)
+		reviewers, err := u.prRep.GetReviewersIdByPR(ctx, prId)
 		if err != nil {
-			u.logger.Error("failed to merge PR", "pull_request_id", prId, "error", err)
-			return nil, entity.ErrInternalError
+			u.logger.Error("failed to get reviewers for PR", "pull_request_id", prId, "error", err)
+			return entity.ErrInternalError
 		}
-	} else {
-		u.logger.Info("PR is not OPEN, skipping merge", "pull_request_id", prId)
-	}
 
-	pr, err := u.prRep.GetPRById(ctx, prId)
-	if err != nil {
-		u.logger.Error("failed to get PR", "pull_request_id", prId, "error", err)
-		return nil, entity.ErrInternalError
+		pr.AssignedReviewers = reviewers
+
+		if !open {
+			return nil
+		}
+
+		return publishEvents(ctx, u.events, u.logger, entity.NewPREvent(entity.EventPRMerged, pr))
 	}
 
-	reviewers, err := u.prRep.GetReviewersIdByPR(ctx, prId)
+	err := withRetry(ctx, func(ctx context.Context) error {
+		return u.txMgr.WithTx(ctx, operation)
+	}, 3)
+
 	if err != nil {
-		u.logger.Error("failed to get reviewers for PR", "pull_request_id", prId, "error", err)
-		return nil, entity.ErrInternalError
+		return nil, err
 	}
 
-	pr.AssignedReviewers = reviewers
-
 	u.logger.Info("successfully merged PR", "pull_request_id", prId)
 	return pr, nil
 
 }
 
-func (u *PRUsecase) CreatePR(ctx context.Context, prId string, prName string, authorId string) (*entity.PullRequest, error) {
-	u.logger.Info("start creating PR", "pull_request_id", prId, "pull_request_name", prName, "author_id", authorId)
+func (u *PRUsecase) ClosePR(ctx context.Context, prId string) (*entity.PullRequest, error) {
+	u.logger.Info("start closing PR", "pull_request_id", prId)
+	return u.setStatus(ctx, prId, entity.OPEN, entity.CLOSED, entity.EventPRClosed)
+}
 
-	if prId == "" || prName == "" || authorId == "" {
-		u.logger.Warn("invalid data: empty fields", "pull_request_id", prId, "pull_request_name", prName, "author_id", authorId)
+func (u *PRUsecase) ReopenPR(ctx context.Context, prId string) (*entity.PullRequest, error) {
+	u.logger.Info("start reopening PR", "pull_request_id", prId)
+	return u.setStatus(ctx, prId, entity.CLOSED, entity.OPEN, entity.EventPRReopened)
+}
+
+func (u *PRUsecase) setStatus(ctx context.Context, prId string, from, to entity.Status, eventType entity.EventType) (*entity.PullRequest, error) {
+	if prId == "" {
+		u.logger.Warn("invalid pull_request_id: empty", "pull_request_id", prId)
 		return nil, entity.ErrInvalidRequest
 	}
 
-	prShort := &entity.PullRequestShort{PullRequestID: prId, PullRequestName: prName, AuthorID: authorId, Status: entity.OPEN}
-	createdPR := entity.PullRequest{PullRequestID: prId, PullRequestName: prName, AuthorID: authorId, Status: entity.OPEN}
+	var pr *entity.PullRequest
 
 	operation := func(ctx context.Context) error {
-		exist, err := u.prRep.IsPRExist(ctx, prId)
-		if err != nil {
-			if !errors.Is(err, entity.ErrNotFound) {
-				u.logger.Error("failed to check PR existence", "pull_request_id", prId, "error", err)
-				return entity.ErrInternalError
-			}
-		}
-
-		if exist {
-			u.logger.Warn("PR exists", "pull_request_id", prId)
-			return entity.ErrPRExists
-		}
-
-		_, err = u.userRep.IsUserExist(ctx, authorId)
+		var err error
+		pr, err = u.prRep.GetPRById(ctx, prId)
 		if err != nil {
 			if errors.Is(err, entity.ErrNotFound) {
-				u.logger.Warn("author not found", "author_id", authorId, "error", err)
+				u.logger.Warn("PR not found", "pull_request_id", prId, "error", err)
 				return err
 			}
-			u.logger.Error("failed to check user existence", "author_id", authorId, "error", err)
+			u.logger.Error("failed to get PR", "pull_request_id", prId, "error", err)
 			return entity.ErrInternalError
 		}
 
-		teamName, err := u.teamRep.GetTeamNameByUserId(ctx, authorId)
+		if pr.Status == entity.MERGED {
+			u.logger.Warn("cannot change status of MERGED PR", "pull_request_id", prId)
+			return entity.ErrPRMerged
+		}
+
+		pr, err = u.withReviewers(ctx, pr)
 		if err != nil {
-			if errors.Is(err, entity.ErrNotFound) {
-				u.logger.Warn("team not found", "user_id", authorId, "error", err)
-				return err
-			}
-			u.logger.Error("failed to get team", "user_id", authorId, "error", err)
-			return entity.ErrInternalError
+			return err
 		}
 
-		activeUsers, err := u.userRep.GetActiveUsersByTeam(ctx, *teamName)
+		if pr.Status != from {
+			u.logger.Info("PR already has status, skipping", "pull_request_id", prId, "status", pr.Status)
+			return nil
+		}
 
-		if err != nil {
-			u.logger.Error("failed to get active users", "team_name", teamName, "error", err)
+		if err := u.prRep.SetPRStatus(ctx, prId, to); err != nil {
+			u.logger.Error("failed to set PR status", "pull_request_id", prId, "status", to, "error", err)
 			return entity.ErrInternalError
 		}
 
-		candidates := make([]string, 0, len(activeUsers))
+		pr.Status = to
+
+		return publishEvents(ctx, u.events, u.logger, entity.NewPREvent(eventType, pr))
+	}
+
+	err := withRetry(ctx, func(ctx context.Context) error {
+		return u.txMgr.WithTx(ctx, operation)
+	}, 3)
+
+	if err != nil {
+		return nil, err
+	}
+
+	u.logger.Info("PR status set", "pull_request_id", prId, "status", pr.Status)
+
+	return pr, nil
+}
+
+func (u *PRUsecase) CreatePR(ctx context.Context, req *entity.CreatePRRequest) (*entity.PullRequest, error) {
+	prId, prName, authorId := req.PullRequestID, req.PullRequestName, req.AuthorID
+	u.logger.Info("start creating PR", "pull_request_id", prId, "pull_request_name", prName, "author_id", authorId, "changed_files", len(req.ChangedFiles))
+
+	if prId == "" || prName == "" || authorId == "" {
+		u.logger.Warn("invalid data: empty fields", "pull_request_id", prId, "pull_request_name", prName, "author_id", authorId)
+		return nil, entity.ErrInvalidRequest
+	}
 
-		for _, memberId := range activeUsers {
-			if memberId != authorId {
-				candidates = append(candidates, memberId)
+	tags, err := entity.NormalizeTags(req.Tags)
+	if err != nil {
+		u.logger.Warn("invalid PR tags", "pull_request_id", prId, "error", err)
+		return nil, err
+	}
+
+	createdPR := entity.PullRequest{PullRequestID: prId, PullRequestName: prName, AuthorID: authorId, Status: entity.OPEN, Tags: tags}
+
+	operation := func(ctx context.Context) error {
+		exist, err := u.prRep.IsPRExist(ctx, prId)
+		if err != nil {
+			if !errors.Is(err, entity.ErrNotFound) {
+				u.logger.Error("failed to check PR existence", "pull_request_id", prId, "error", err)
+				return entity.ErrInternalError
 			}
 		}
 
-		rand.Shuffle(len(candidates), func(i, j int) {
-			candidates[i], candidates[j] = candidates[j], candidates[i]
-		})
+		if exist {
+			u.logger.Warn("PR exists", "pull_request_id", prId)
+			return entity.ErrPRExists
+		}
 
-		reviewers := candidates
-		if len(reviewers) > 2 {
-			reviewers = reviewers[:2]
+		selection, err := u.selectForNewPR(ctx, authorId, req.ChangedFiles, tags)
+		if err != nil {
+			return err
 		}
 
-		if err := u.prRep.CreatePR(ctx, prShort); err != nil {
+		reviewers := entity.ReviewerIDs(selection.Reviewers)
+
+		if err := u.prRep.CreatePR(ctx, &createdPR); err != nil {
 			u.logger.Error("failed to create PR", "pull_request_id", prId, "pull_request_name", prName, "author_id", authorId, "error", err)
 			return entity.ErrInternalError
 		}
@@ -151,12 +214,29 @@ func (u *PRUsecase) CreatePR(ctx context.Context, prId string, prName string, au
 			}
 		}
 
+		record := &entity.AssignmentRecord{PullRequestID: prId, Action: entity.AssignmentCreated, ReviewerIDs: reviewers, Explanation: selection.Explanation}
+		if err := u.prRep.AddAssignmentRecord(ctx, record); err != nil {
+			u.logger.Error("failed to record reviewer assignment", "pull_request_id", prId, "error", err)
+			return entity.ErrInternalError
+		}
+
 		createdPR.AssignedReviewers = reviewers
-		return nil
+		createdPR.FallbackReviewers = entity.FallbackReviewers(selection.Reviewers)
+		createdPR.Warnings = selection.Warnings
+		createdPR.Explanation = &selection.Explanation
+
+		events := []entity.Event{entity.NewPREvent(entity.EventPRCreated, &createdPR)}
 
+		if len(reviewers) > 0 {
+			assigned := entity.NewPREvent(entity.EventReviewerAssigned, &createdPR)
+			assigned.ReviewerIDs = reviewers
+			events = append(events, assigned)
+		}
+
+		return publishEvents(ctx, u.events, u.logger, events...)
 	}
 
-	err := withRetry(ctx, func(ctx context.Context) error {
+	err = withRetry(ctx, func(ctx context.Context) error {
 		return u.txMgr.WithTx(ctx, operation)
 	}, 3)
 
@@ -170,8 +250,110 @@ func (u *PRUsecase) CreatePR(ctx context.Context, prId string, prName string, au
 
 }
 
+func (u *PRUsecase) SuggestReviewers(ctx context.Context, req *entity.SuggestReviewersRequest) (*entity.ReviewerSelection, error) {
+	u.logger.Info("start suggesting reviewers", "author_id", req.AuthorID, "changed_files", len(req.ChangedFiles))
+
+	if req.AuthorID == "" {
+		u.logger.Warn("invalid author_id: empty", "author_id", req.AuthorID)
+		return nil, entity.ErrInvalidRequest
+	}
+
+	tags, err := entity.NormalizeTags(req.Tags)
+	if err != nil {
+		u.logger.Warn("invalid PR tags", "author_id", req.AuthorID, "error", err)
+		return nil, err
+	}
+
+	selection, err := u.selectForNewPR(ctx, req.AuthorID, req.ChangedFiles, tags)
+	if err != nil {
+		return nil, err
+	}
+
+	u.logger.Info("reviewers suggested successfully", "author_id", req.AuthorID, "reviewers", entity.ReviewerIDs(selection.Reviewers))
+
+	return selection, nil
+}
+
+func (u *PRUsecase) selectForNewPR(ctx context.Context, authorId string, changedFiles, tags []string) (*entity.ReviewerSelection, error) {
+	_, err := u.userRep.IsUserExist(ctx, authorId)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			u.logger.Warn("author not found", "author_id", authorId, "error", err)
+			return nil, err
+		}
+		u.logger.Error("failed to check user existence", "author_id", authorId, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	teamName, err := u.teamRep.GetTeamNameByUserId(ctx, authorId)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			u.logger.Warn("team not found", "user_id", authorId, "error", err)
+			return nil, err
+		}
+		u.logger.Error("failed to get team", "user_id", authorId, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	selection, err := u.selector.Select(ctx, entity.ReviewerQuery{
+		TeamName:     *teamName,
+		AuthorID:     authorId,
+		Count:        requiredReviewers,
+		ChangedFiles: changedFiles,
+		Tags:         tags,
+	})
+
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidRequest) {
+			u.logger.Warn("invalid code owner rules", "team_name", teamName, "error", err)
+			return nil, err
+		}
+		u.logger.Error("failed to select reviewers", "team_name", teamName, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	if len(selection.Reviewers) < requiredReviewers {
+		u.logger.Warn("not enough reviewer candidates", "author_id", authorId, "required", requiredReviewers, "assigned", len(selection.Reviewers))
+		selection.Warnings = append(selection.Warnings, fmt.Sprintf("only %d of %d required reviewers assigned: not enough available candidates", len(selection.Reviewers), requiredReviewers))
+	}
+
+	return selection, nil
+}
+
+type reassignment struct {
+	prId          string
+	oldReviewerId string
+	newReviewerId string
+	action        entity.AssignmentAction
+	reason        string
+}
+
 func (u *PRUsecase) ReAssign(ctx context.Context, prId, oldReviewerId string) (*entity.PullRequest, error) {
-	u.logger.Info("start reassigning reviewer", "pull_request_id", prId, "old_reviewer_id", oldReviewerId)
+	return u.reassign(ctx, reassignment{prId: prId, oldReviewerId: oldReviewerId, action: entity.AssignmentReassigned})
+}
+
+func (u *PRUsecase) ReAssignTo(ctx context.Context, prId, oldReviewerId, newReviewerId string) (*entity.PullRequest, error) {
+	if newReviewerId == "" {
+		u.logger.Warn("invalid new_reviewer_id: empty", "pull_request_id", prId)
+		return nil, entity.ErrInvalidRequest
+	}
+
+	return u.reassign(ctx, reassignment{prId: prId, oldReviewerId: oldReviewerId, newReviewerId: newReviewerId, action: entity.AssignmentReassigned})
+}
+
+func (u *PRUsecase) Decline(ctx context.Context, prId, reviewerId, reason string) (*entity.PullRequest, error) {
+	if strings.TrimSpace(reason) == "" {
+		u.logger.Warn("invalid decline reason: empty", "pull_request_id", prId, "reviewer_id", reviewerId)
+		return nil, fmt.Errorf("%w: empty decline reason", entity.ErrInvalidRequest)
+	}
+
+	return u.reassign(ctx, reassignment{prId: prId, oldReviewerId: reviewerId, action: entity.AssignmentDeclined, reason: reason})
+}
+
+func (u *PRUsecase) reassign(ctx context.Context, r reassignment) (*entity.PullRequest, error) {
+	prId, oldReviewerId := r.prId, r.oldReviewerId
+	u.logger.Info("start reassigning reviewer", "pull_request_id", prId, "old_reviewer_id", oldReviewerId,
+		"new_reviewer_id", r.newReviewerId, "action", r.action)
 
 	if prId == "" || oldReviewerId == "" {
 		u.logger.Warn("invalid data: empty fields", "pull_request_id", prId, "old_reviewer_id", oldReviewerId)
@@ -223,8 +405,8 @@ func (u *PRUsecase) ReAssign(ctx context.Context, prId, oldReviewerId string) (*
 		}
 
 		if !open {
-			u.logger.Warn("failed to assign reviewer for MERGED PR", "pull_request_id", prId)
-			return entity.ErrPRMerged
+			u.logger.Warn("failed to assign reviewer for not OPEN PR", "pull_request_id", prId)
+			return u.notOpenError(ctx, prId)
 		}
 
 		teamName, err := u.teamRep.GetTeamNameByUserId(ctx, oldReviewerId)
@@ -243,39 +425,69 @@ func (u *PRUsecase) ReAssign(ctx context.Context, prId, oldReviewerId string) (*
 			return entity.ErrInternalError
 		}
 
-		activeUsers, err := u.userRep.GetActiveUsersByTeam(ctx, *teamName)
-
+		currentReviewers, err := u.prRep.GetReviewersIdByPR(ctx, prId)
 		if err != nil {
-			u.logger.Error("failed to get active users", "team_name", teamName, "error", err)
+			u.logger.Error("failed to get reviewers", "pull_request_id", prId, "error", err)
 			return entity.ErrInternalError
 		}
 
-		candidates := make([]string, 0, len(activeUsers))
+		var selection *entity.ReviewerSelection
 
-		for _, memberId := range activeUsers {
-			if memberId != oldReviewerId && memberId != pr.AuthorID {
-				candidates = append(candidates, memberId)
+		if r.newReviewerId != "" {
+			selection, err = u.explicitReviewer(ctx, pr, currentReviewers, r.newReviewerId)
+			if err != nil {
+				return err
+			}
+		} else {
+			selection, err = u.selector.Select(ctx, entity.ReviewerQuery{
+				TeamName: *teamName,
+				AuthorID: pr.AuthorID,
+				Count:    1,
+				Exclude:  currentReviewers,
+				Tags:     pr.Tags,
+				Retained: excludeCandidates(currentReviewers, oldReviewerId),
+			})
+
+			if err != nil {
+				u.logger.Error("failed to select reviewers", "team_name", teamName, "error", err)
+				return entity.ErrInternalError
 			}
 		}
 
-		rand.Shuffle(len(candidates), func(i, j int) {
-			candidates[i], candidates[j] = candidates[j], candidates[i]
-		})
+		if len(selection.Reviewers) == 0 {
+			if r.action != entity.AssignmentDeclined {
+				u.logger.Warn("no available candidates for PR reviewers", "pull_request_id", prId)
+				return entity.ErrNoCandidate
+			}
 
-		if len(candidates) == 0 {
-			u.logger.Warn("no available candidates for PR reviewers", "pull_request_id", prId)
-			return entity.ErrNoCandidate
+			u.logger.Warn("no replacement for declined reviewer", "pull_request_id", prId, "old_reviewer_id", oldReviewerId)
+			selection.Warnings = append(selection.Warnings, "no available replacement reviewer: review declined without replacement")
 		}
 
-		newReviewerId := candidates[0]
-
 		if err = u.prRep.DeleteReviewer(ctx, prId, oldReviewerId); err != nil {
 			u.logger.Error("failed to delete reviewer for PR", "pull_request_id", prId, "old_reviewer_id", oldReviewerId, "error", err)
 			return entity.ErrInternalError
 		}
 
-		if err := u.prRep.AddReviewerForPR(ctx, prId, newReviewerId); err != nil {
-			u.logger.Error("failed to add reviewer to PR", "pull_request_id", prId, "reviewer_id", newReviewerId, "error", err)
+		newReviewers := entity.ReviewerIDs(selection.Reviewers)
+
+		for _, newReviewerId := range newReviewers {
+			if err := u.prRep.AddReviewerForPR(ctx, prId, newReviewerId); err != nil {
+				u.logger.Error("failed to add reviewer to PR", "pull_request_id", prId, "reviewer_id", newReviewerId, "error", err)
+				return entity.ErrInternalError
+			}
+		}
+
+		record := &entity.AssignmentRecord{
+			PullRequestID: prId,
+			Action:        r.action,
+			ReviewerIDs:   newReviewers,
+			OldReviewerID: oldReviewerId,
+			Reason:        r.reason,
+			Explanation:   selection.Explanation,
+		}
+		if err := u.prRep.AddAssignmentRecord(ctx, record); err != nil {
+			u.logger.Error("failed to record reviewer assignment", "pull_request_id", prId, "error", err)
 			return entity.ErrInternalError
 		}
 
@@ -293,8 +505,165 @@ func (u *PRUsecase) ReAssign(ctx context.Context, prId, oldReviewerId string) (*
 			AssignedReviewers: reviewers,
 			CreatedAt:         pr.CreatedAt,
 			MergedAt:          pr.MergedAt,
+			Tags:              pr.Tags,
+			Warnings:          selection.Warnings,
+			FallbackReviewers: entity.FallbackReviewers(selection.Reviewers),
+			Explanation:       &selection.Explanation,
+		}
+
+		if len(newReviewers) == 0 {
+			return nil
+		}
+
+		event := entity.NewPREvent(entity.EventReviewerReassigned, resultPR)
+		event.ReviewerIDs = newReviewers
+		event.OldReviewerID = oldReviewerId
+		return publishEvents(ctx, u.events, u.logger, event)
+	}
+
+	err := withRetry(ctx, func(ctx context.Context) error {
+		return u.txMgr.WithTx(ctx, operation)
+	}, 3)
+
+	if err != nil {
+		return nil, err
+	}
+
+	u.logger.Info("reassigning reviewer finished successfully", "pull_request_id", prId, "old_reviewer_id", oldReviewerId, "action", r.action)
+
+	return resultPR, nil
+
+}
+
+func (u *PRUsecase) explicitReviewer(ctx context.Context, pr *entity.PullRequest, currentReviewers []string, reviewerId string) (*entity.ReviewerSelection, error) {
+	if pr.AuthorID == reviewerId {
+		u.logger.Warn("author cannot review own PR", "pull_request_id", pr.PullRequestID, "reviewer_id", reviewerId)
+		return nil, entity.ErrUserIsAuthor
+	}
+
+	if slices.Contains(currentReviewers, reviewerId) {
+		u.logger.Warn("reviewer already assigned", "pull_request_id", pr.PullRequestID, "reviewer_id", reviewerId)
+		return nil, entity.ErrAssigned
+	}
+
+	reviewer, err := u.userRep.GetUserById(ctx, reviewerId)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			u.logger.Warn("reviewer not found", "reviewer_id", reviewerId, "error", err)
+			return nil, err
+		}
+		u.logger.Error("failed to get reviewer", "reviewer_id", reviewerId, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	if !reviewer.IsActive {
+		u.logger.Warn("reviewer is inactive", "reviewer_id", reviewerId)
+		return nil, fmt.Errorf("%w: reviewer is inactive", entity.ErrInvalidRequest)
+	}
+
+	assignment := entity.ReviewerAssignment{UserID: reviewerId, TeamName: reviewer.TeamName, Reason: entity.SelectionManual}
+
+	return &entity.ReviewerSelection{
+		Reviewers:   []entity.ReviewerAssignment{assignment},
+		Explanation: entity.SelectionExplanation{Reviewers: []entity.ReviewerAssignment{assignment}},
+	}, nil
+}
+
+func (u *PRUsecase) GetAssignmentHistory(ctx context.Context, prId string) ([]entity.AssignmentRecord, error) {
+	u.logger.Info("start getting assignment history", "pull_request_id", prId)
+
+	if prId == "" {
+		u.logger.Warn("invalid pull_request_id: empty", "pull_request_id", prId)
+		return nil, entity.ErrInvalidRequest
+	}
+
+	_, err := u.prRep.IsPRExist(ctx, prId)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			u.logger.Warn("PR not found", "pull_request_id", prId, "error", err)
+			return nil, err
 		}
-		return nil
+		u.logger.Error("failed to check PR existence", "pull_request_id", prId, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	history, err := u.prRep.GetAssignmentHistory(ctx, prId)
+	if err != nil {
+		u.logger.Error("failed to get assignment history", "pull_request_id", prId, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	u.logger.Info("successfully got assignment history", "pull_request_id", prId, "records", len(history))
+
+	return history, nil
+}
+
+func (u *PRUsecase) AddReviewer(ctx context.Context, prId, reviewerId string) (*entity.PullRequest, error) {
+	u.logger.Info("start adding reviewer", "pull_request_id", prId, "reviewer_id", reviewerId)
+
+	if prId == "" || reviewerId == "" {
+		u.logger.Warn("invalid data: empty fields", "pull_request_id", prId, "reviewer_id", reviewerId)
+		return nil, entity.ErrInvalidRequest
+	}
+
+	var resultPR *entity.PullRequest
+
+	operation := func(ctx context.Context) error {
+		pr, err := u.openPR(ctx, prId)
+		if err != nil {
+			return err
+		}
+
+		if pr.AuthorID == reviewerId {
+			u.logger.Warn("author cannot review own PR", "pull_request_id", prId, "reviewer_id", reviewerId)
+			return entity.ErrUserIsAuthor
+		}
+
+		reviewer, err := u.userRep.GetUserById(ctx, reviewerId)
+		if err != nil {
+			if errors.Is(err, entity.ErrNotFound) {
+				u.logger.Warn("reviewer not found", "reviewer_id", reviewerId, "error", err)
+				return err
+			}
+			u.logger.Error("failed to get reviewer", "reviewer_id", reviewerId, "error", err)
+			return entity.ErrInternalError
+		}
+
+		if !reviewer.IsActive {
+			u.logger.Warn("reviewer is inactive", "reviewer_id", reviewerId)
+			return fmt.Errorf("%w: reviewer is inactive", entity.ErrInvalidRequest)
+		}
+
+		_, err = u.prRep.IsReviewerForPR(ctx, prId, reviewerId)
+		if err == nil {
+			u.logger.Warn("reviewer already assigned", "pull_request_id", prId, "reviewer_id", reviewerId)
+			return entity.ErrAssigned
+		}
+
+		if !errors.Is(err, entity.ErrNotAssigned) {
+			u.logger.Error("failed to check reviewer for PR", "pull_request_id", prId, "reviewer_id", reviewerId, "error", err)
+			return entity.ErrInternalError
+		}
+
+		if err := u.prRep.AddReviewerForPR(ctx, prId, reviewerId); err != nil {
+			u.logger.Error("failed to add reviewer to PR", "pull_request_id", prId, "reviewer_id", reviewerId, "error", err)
+			return entity.ErrInternalError
+		}
+
+		record := &entity.AssignmentRecord{PullRequestID: prId, Action: entity.AssignmentAdded, ReviewerIDs: []string{reviewerId}}
+		if err := u.prRep.AddAssignmentRecord(ctx, record); err != nil {
+			u.logger.Error("failed to record reviewer assignment", "pull_request_id", prId, "error", err)
+			return entity.ErrInternalError
+		}
+
+		resultPR, err = u.withReviewers(ctx, pr)
+		if err != nil {
+			return err
+		}
+
+		event := entity.NewPREvent(entity.EventReviewerAssigned, resultPR)
+		event.ReviewerIDs = []string{reviewerId}
+		return publishEvents(ctx, u.events, u.logger, event)
 	}
 
 	err := withRetry(ctx, func(ctx context.Context) error {
@@ -305,8 +674,189 @@ func (u *PRUsecase) ReAssign(ctx context.Context, prId, oldReviewerId string) (*
 		return nil, err
 	}
 
-	u.logger.Info("reassigning reviewer finished successfully", "pull_request_id", prId, "old_reviewer_id", oldReviewerId)
+	u.logger.Info("reviewer added successfully", "pull_request_id", prId, "reviewer_id", reviewerId)
 
 	return resultPR, nil
+}
+
+func (u *PRUsecase) RemoveReviewer(ctx context.Context, prId, reviewerId string) (*entity.PullRequest, error) {
+	u.logger.Info("start removing reviewer", "pull_request_id", prId, "reviewer_id", reviewerId)
+
+	if prId == "" || reviewerId == "" {
+		u.logger.Warn("invalid data: empty fields", "pull_request_id", prId, "reviewer_id", reviewerId)
+		return nil, entity.ErrInvalidRequest
+	}
+
+	var resultPR *entity.PullRequest
+
+	operation := func(ctx context.Context) error {
+		pr, err := u.openPR(ctx, prId)
+		if err != nil {
+			return err
+		}
+
+		_, err = u.prRep.IsReviewerForPR(ctx, prId, reviewerId)
+		if err != nil {
+			if errors.Is(err, entity.ErrNotAssigned) {
+				u.logger.Warn("reviewer not assigned for PR", "pull_request_id", prId, "reviewer_id", reviewerId)
+				return err
+			}
+			u.logger.Error("failed to check reviewer for PR", "pull_request_id", prId, "reviewer_id", reviewerId, "error", err)
+			return entity.ErrInternalError
+		}
+
+		if err := u.prRep.DeleteReviewer(ctx, prId, reviewerId); err != nil {
+			u.logger.Error("failed to delete reviewer for PR", "pull_request_id", prId, "reviewer_id", reviewerId, "error", err)
+			return entity.ErrInternalError
+		}
+
+		record := &entity.AssignmentRecord{PullRequestID: prId, Action: entity.AssignmentRemoved, OldReviewerID: reviewerId}
+		if err := u.prRep.AddAssignmentRecord(ctx, record); err != nil {
+			u.logger.Error("failed to record reviewer assignment", "pull_request_id", prId, "error", err)
+			return entity.ErrInternalError
+		}
+
+		resultPR, err = u.withReviewers(ctx, pr)
+		if err != nil {
+			return err
+		}
+
+		event := entity.NewPREvent(entity.EventReviewerRemoved, resultPR)
+		event.OldReviewerID = reviewerId
+		return publishEvents(ctx, u.events, u.logger, event)
+	}
+
+	err := withRetry(ctx, func(ctx context.Context) error {
+		return u.txMgr.WithTx(ctx, operation)
+	}, 3)
+
+	if err != nil {
+		return nil, err
+	}
+
+	u.logger.Info("reviewer removed successfully", "pull_request_id", prId, "reviewer_id", reviewerId)
+
+	return resultPR, nil
+}
+
+func (u *PRUsecase) openPR(ctx context.Context, prId string) (*entity.PullRequest, error) {
+	open, err := u.prRep.IsPROpen(ctx, prId)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			u.logger.Warn("PR not found", "pull_request_id", prId, "error", err)
+			return nil, err
+		}
+		u.logger.Error("failed to check PR status", "pull_request_id", prId, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	if !open {
+		u.logger.Warn("cannot change reviewers of not OPEN PR", "pull_request_id", prId)
+		return nil, u.notOpenError(ctx, prId)
+	}
+
+	pr, err := u.prRep.GetPRById(ctx, prId)
+	if err != nil {
+		u.logger.Error("failed to get PR", "pull_request_id", prId, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	return pr, nil
+}
+
+func (u *PRUsecase) notOpenError(ctx context.Context, prId string) error {
+	pr, err := u.prRep.GetPRById(ctx, prId)
+	if err != nil {
+		u.logger.Error("failed to get PR", "pull_request_id", prId, "error", err)
+		return entity.ErrInternalError
+	}
+
+	if pr.Status == entity.CLOSED {
+		return entity.ErrPRClosed
+	}
+
+	return entity.ErrPRMerged
+}
+
+func (u *PRUsecase) withReviewers(ctx context.Context, pr *entity.PullRequest) (*entity.PullRequest, error) {
+	reviewers, err := u.prRep.GetReviewersIdByPR(ctx, pr.PullRequestID)
+	if err != nil {
+		u.logger.Error("failed to get reviewers", "pull_request_id", pr.PullRequestID, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	states, err := u.prRep.GetReviewStates(ctx, pr.PullRequestID)
+	if err != nil {
+		u.logger.Error("failed to get review states", "pull_request_id", pr.PullRequestID, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	now := time.Now()
+	pr.OverdueReviewers = nil
+	pr.ReviewAges = make(map[string]time.Duration)
+
+	for _, state := range states {
+		if state.Overdue() {
+			pr.OverdueReviewers = append(pr.OverdueReviewers, state.ReviewerID)
+		}
+		if state.Pending() {
+			pr.ReviewAges[state.ReviewerID] = state.WorkingHours.Elapsed(state.AssignedAt, now)
+		}
+	}
+
+	pr.AssignedReviewers = reviewers
+	return pr, nil
+}
+
+func (u *PRUsecase) Respond(ctx context.Context, prId, reviewerId string) (*entity.PullRequest, error) {
+	u.logger.Info("start recording reviewer response", "pull_request_id", prId, "reviewer_id", reviewerId)
+
+	if prId == "" || reviewerId == "" {
+		u.logger.Warn("invalid data: empty fields", "pull_request_id", prId, "reviewer_id", reviewerId)
+		return nil, entity.ErrInvalidRequest
+	}
+
+	pr, err := u.openPR(ctx, prId)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = u.prRep.IsReviewerForPR(ctx, prId, reviewerId)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotAssigned) {
+			u.logger.Warn("reviewer not assigned for PR", "pull_request_id", prId, "reviewer_id", reviewerId)
+			return nil, err
+		}
+		u.logger.Error("failed to check reviewer for PR", "pull_request_id", prId, "reviewer_id", reviewerId, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	if err := u.prRep.MarkReviewResponded(ctx, prId, reviewerId, time.Now()); err != nil {
+		u.logger.Error("failed to mark review responded", "pull_request_id", prId, "reviewer_id", reviewerId, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	u.logger.Info("reviewer response recorded", "pull_request_id", prId, "reviewer_id", reviewerId)
+
+	return u.withReviewers(ctx, pr)
+}
+
+func (u *PRUsecase) GetOverduePRs(ctx context.Context) ([]entity.PullRequest, error) {
+	u.logger.Info("start getting overdue PRs")
+
+	prs, err := u.prRep.GetOverduePRs(ctx)
+	if err != nil {
+		u.logger.Error("failed to get overdue PRs", "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	for i := range prs {
+		if _, err := u.withReviewers(ctx, &prs[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	u.logger.Info("successfully got overdue PRs", "count", len(prs))
 
+	return prs, nil
 }
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"pullrequest-service/internal/entity"
+	"time"
+)
+
+type ReviewSLAUsecase struct {
+	prRep      PRRepository
+	reassigner ReviewReassigner
+	txMgr      TxManager
+	logger     *slog.Logger
+}
+
+func NewReviewSLAUsecase(prRep PRRepository, reassigner ReviewReassigner, txMgr TxManager, logger *slog.Logger) *ReviewSLAUsecase {
+	return &ReviewSLAUsecase{prRep: prRep, reassigner: reassigner, txMgr: txMgr, logger: logger}
+}
+
+func (u *ReviewSLAUsecase) EscalateOverdue(ctx context.Context) error {
+	now := time.Now()
+
+	pending, err := u.prRep.GetPendingSLAReviews(ctx)
+	if err != nil {
+		u.logger.Error("failed to get pending SLA reviews", "error", err)
+		return entity.ErrInternalError
+	}
+
+	for _, review := range pending {
+		age := review.WorkingHours.Elapsed(review.AssignedAt, now)
+		if age < time.Duration(review.SLAHours)*time.Hour {
+			continue
+		}
+
+		u.logger.Info("review SLA exceeded", "pull_request_id", review.PullRequestID, "reviewer_id", review.ReviewerID, "assigned_at", review.AssignedAt,
+			"working_age", age, "action", review.Action)
+
+		if review.Action == entity.SLAActionReassign {
+			_, err := u.reassigner.ReAssign(ctx, review.PullRequestID, review.ReviewerID)
+			if err == nil {
+				continue
+			}
+
+			switch {
+			case errors.Is(err, entity.ErrNoCandidate):
+				u.logger.Warn("no replacement for overdue reviewer, flagging instead", "pull_request_id", review.PullRequestID, "reviewer_id", review.ReviewerID)
+			case errors.Is(err, entity.ErrNotAssigned) || errors.Is(err, entity.ErrPRMerged) || errors.Is(err, entity.ErrPRClosed):
+				u.logger.Info("review no longer needs escalation", "pull_request_id", review.PullRequestID, "reviewer_id", review.ReviewerID, "error", err)
+				continue
+			default:
+				// The review stays pending and is escalated again on the next
+				// pass; it must not hold up the reviews after it.
+				u.logger.Error("failed to reassign overdue review", "pull_request_id", review.PullRequestID, "reviewer_id", review.ReviewerID, "error", err)
+				continue
+			}
+		}
+
+		u.flag(ctx, review, now)
+	}
+
+	return nil
+}
+
+// flag marks the review overdue together with its history record. A failure
+// is only logged: the review stays pending and is flagged on the next pass.
+func (u *ReviewSLAUsecase) flag(ctx context.Context, review entity.SLAReview, now time.Time) {
+	operation := func(ctx context.Context) error {
+		if err := u.prRep.MarkReviewOverdue(ctx, review.PullRequestID, review.ReviewerID, now); err != nil {
+			u.logger.Error("failed to mark review overdue", "pull_request_id", review.PullRequestID, "reviewer_id", review.ReviewerID, "error", err)
+			return entity.ErrInternalError
+		}
+
+		record := &entity.AssignmentRecord{PullRequestID: review.PullRequestID, Action: entity.AssignmentOverdue, OldReviewerID: review.ReviewerID,
+			Reason: "review SLA exceeded"}
+		if err := u.prRep.AddAssignmentRecord(ctx, record); err != nil {
+			u.logger.Error("failed to record overdue review", "pull_request_id", review.PullRequestID, "error", err)
+			return entity.ErrInternalError
+		}
+
+		return nil
+	}
+
+	if err := u.txMgr.WithTx(ctx, operation); err != nil {
+		u.logger.Error("failed to flag overdue review", "pull_request_id", review.PullRequestID, "reviewer_id", review.ReviewerID, "error", err)
+		return
+	}
+
+	u.logger.Info("review flagged as overdue", "pull_request_id", review.PullRequestID, "reviewer_id", review.ReviewerID)
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"pullrequest-service/internal/entity"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestEscalateOverdue(t *testing.T) {
+	assignedAt := time.Now().Add(-7 * 24 * time.Hour)
+	overdue := func(prId string, action entity.SLAAction) entity.SLAReview {
+		return entity.SLAReview{PullRequestID: prId, ReviewerID: "u1", AssignedAt: assignedAt, SLAHours: 1, Action: action}
+	}
+
+	repo := &fakeSLARepository{
+		pending: []entity.SLAReview{
+			overdue("pr-broken", entity.SLAActionReassign),
+			overdue("pr-no-candidate", entity.SLAActionReassign),
+			overdue("pr-no-history", entity.SLAActionFlag),
+			overdue("pr-flag", entity.SLAActionFlag),
+			overdue("pr-reassign", entity.SLAActionReassign),
+			{PullRequestID: "pr-fresh", ReviewerID: "u1", AssignedAt: time.Now(), SLAHours: 1000, Action: entity.SLAActionFlag},
+		},
+		failRecord: map[string]bool{"pr-no-history": true},
+	}
+	reassigner := &fakeReassigner{errs: map[string]error{
+		"pr-broken":       errors.New("connection reset"),
+		"pr-no-candidate": entity.ErrNoCandidate,
+	}}
+
+	u := NewReviewSLAUsecase(repo, reassigner, fakeTxManager{}, discardLogger())
+
+	if err := u.EscalateOverdue(context.Background()); err != nil {
+		t.Fatalf("EscalateOverdue() error = %v", err)
+	}
+
+	if want := []string{"pr-broken", "pr-no-candidate", "pr-reassign"}; !slices.Equal(reassigner.calls, want) {
+		t.Errorf("reassigned = %v, want %v", reassigner.calls, want)
+	}
+
+	flagged := make([]string, 0, len(repo.records))
+	for _, record := range repo.records {
+		flagged = append(flagged, record.PullRequestID)
+	}
+
+	// pr-no-history fails to record its history; the reviews after it are
+	// still escalated.
+	if want := []string{"pr-no-candidate", "pr-flag"}; !slices.Equal(flagged, want) {
+		t.Errorf("flagged = %v, want %v", flagged, want)
+	}
+}
@@ -0,0 +1,399 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"pullrequest-service/internal/entity"
+	"slices"
+	"time"
+)
+
+type ReviewerSelector struct {
+	userRep         UserRepository
+	teamRep         TeamRepository
+	prRep           PRRepository
+	availabilityRep AvailabilityRepository
+	codeOwners      *CodeOwners
+	logger          *slog.Logger
+}
+
+func NewReviewerSelector(userRep UserRepository, teamRep TeamRepository, prRep PRRepository, availabilityRep AvailabilityRepository, codeOwners *CodeOwners, logger *slog.Logger) *ReviewerSelector {
+	return &ReviewerSelector{userRep: userRep, teamRep: teamRep, prRep: prRep, availabilityRep: availabilityRep, codeOwners: codeOwners, logger: logger}
+}
+
+func (s *ReviewerSelector) Select(ctx context.Context, q entity.ReviewerQuery) (*entity.ReviewerSelection, error) {
+	policy, err := s.teamRep.GetTeamPolicy(ctx, q.TeamName)
+	if err != nil {
+		return nil, fmt.Errorf("get team policy: %w", err)
+	}
+
+	recentReviews := make(map[string]int)
+
+	if policy.RepeatPairingWindow > 0 {
+		recentReviews, err = s.prRep.GetRecentReviewerCounts(ctx, q.AuthorID, policy.RepeatPairingWindow)
+		if err != nil {
+			return nil, fmt.Errorf("get recent reviewers: %w", err)
+		}
+	}
+
+	trace := newSelectionTrace(q.AuthorID)
+
+	selection, err := s.pick(ctx, q, recentReviews, trace)
+	if err != nil {
+		return nil, err
+	}
+
+	if policy.MentorshipRequired {
+		if err := s.applyMentorship(ctx, q, selection, trace); err != nil {
+			return nil, err
+		}
+	}
+
+	selection.Explanation = trace.explanation(selection.Reviewers)
+
+	return selection, nil
+}
+
+func (s *ReviewerSelector) pick(ctx context.Context, q entity.ReviewerQuery, recentReviews map[string]int, trace *selectionTrace) (*entity.ReviewerSelection, error) {
+	skip := append([]string{q.AuthorID}, q.Exclude...)
+	selection := &entity.ReviewerSelection{Reviewers: make([]entity.ReviewerAssignment, 0, q.Count)}
+
+	add := func(a entity.ReviewerAssignment) {
+		selection.Reviewers = append(selection.Reviewers, a)
+		skip = append(skip, a.UserID)
+		trace.markSelected(a.UserID)
+	}
+
+	rules, err := s.codeOwners.MatchingRules(ctx, q.TeamName, q.ChangedFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range rules {
+		if slices.ContainsFunc(selection.Reviewers, func(a entity.ReviewerAssignment) bool { return IsCodeOwner(&rules[i], a) }) {
+			continue
+		}
+
+		owners, err := s.ownerCandidates(ctx, &rules[i], skip, trace)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(owners) == 0 {
+			s.logger.Warn("no available code owner", "team_name", q.TeamName, "pattern", rules[i].Pattern)
+			selection.Warnings = append(selection.Warnings, fmt.Sprintf("no available code owner for %q", rules[i].Pattern))
+			continue
+		}
+
+		add(owners[0])
+	}
+
+	take := func(teamName string, fallback bool) error {
+		candidates, err := s.teamCandidates(ctx, teamName, trace, skip...)
+		if err != nil {
+			return err
+		}
+
+		scores, atWork, err := s.rank(ctx, teamName, candidates, q.Tags, recentReviews)
+		if err != nil {
+			return err
+		}
+
+		reason := entity.SelectionTeam
+		if fallback {
+			reason = entity.SelectionFallback
+		}
+
+		for _, userId := range candidates {
+			if len(selection.Reviewers) >= q.Count {
+				break
+			}
+			add(entity.ReviewerAssignment{UserID: userId, TeamName: teamName, Fallback: fallback, Reason: reason, Score: scores[userId], AtWork: atWork[userId]})
+		}
+
+		return nil
+	}
+
+	if len(selection.Reviewers) >= q.Count {
+		return selection, nil
+	}
+
+	if err := take(q.TeamName, false); err != nil {
+		return nil, err
+	}
+
+	if len(selection.Reviewers) >= q.Count {
+		return selection, nil
+	}
+
+	fallbackTeams, err := s.teamRep.GetFallbackTeams(ctx, q.TeamName)
+	if err != nil {
+		return nil, fmt.Errorf("get fallback teams: %w", err)
+	}
+
+	for _, fallbackTeam := range fallbackTeams {
+		if len(selection.Reviewers) >= q.Count {
+			break
+		}
+
+		s.logger.Info("falling back to linked team", "team_name", q.TeamName, "fallback_team", fallbackTeam, "missing", q.Count-len(selection.Reviewers))
+
+		if err := take(fallbackTeam, true); err != nil {
+			return nil, err
+		}
+	}
+
+	return selection, nil
+}
+
+func (s *ReviewerSelector) applyMentorship(ctx context.Context, q entity.ReviewerQuery, selection *entity.ReviewerSelection, trace *selectionTrace) error {
+	reviewers := append(slices.Clone(q.Retained), entity.ReviewerIDs(selection.Reviewers)...)
+
+	seniorities, err := s.userRep.GetSeniorities(ctx, append([]string{q.AuthorID}, reviewers...))
+	if err != nil {
+		return fmt.Errorf("get seniorities: %w", err)
+	}
+
+	hasLevel := func(level entity.Seniority) bool {
+		return slices.ContainsFunc(reviewers, func(userId string) bool { return seniorities[userId] == level })
+	}
+
+	if seniorities[q.AuthorID] != entity.SeniorityJunior && !hasLevel(entity.SeniorityJunior) {
+		return nil
+	}
+
+	if hasLevel(entity.SenioritySenior) {
+		return nil
+	}
+
+	senior, err := s.seniorCandidate(ctx, q, append(append([]string{q.AuthorID}, q.Exclude...), reviewers...), trace)
+	if err != nil {
+		return err
+	}
+
+	if senior == nil {
+		s.logger.Warn("mentorship policy not satisfied", "team_name", q.TeamName, "author_id", q.AuthorID)
+		selection.Warnings = append(selection.Warnings, "mentorship policy not satisfied: no available senior reviewer")
+		return nil
+	}
+
+	if len(selection.Reviewers) < q.Count {
+		trace.markSelected(senior.UserID)
+		selection.Reviewers = append(selection.Reviewers, *senior)
+		return nil
+	}
+
+	i := evictionIndex(selection.Reviewers, seniorities)
+	if i < 0 {
+		s.logger.Warn("mentorship policy not satisfied", "team_name", q.TeamName, "author_id", q.AuthorID)
+		selection.Warnings = append(selection.Warnings, "mentorship policy not satisfied: no replaceable reviewer for a senior")
+		return nil
+	}
+
+	trace.evict(selection.Reviewers[i].UserID, entity.ExclusionReplacedByMentor)
+	trace.markSelected(senior.UserID)
+	selection.Reviewers[i] = *senior
+
+	return nil
+}
+
+// evictionIndex picks the team reviewer to give up for a mentorship senior:
+// code owners are never replaced, juniors go first, then the lowest score.
+func evictionIndex(reviewers []entity.ReviewerAssignment, seniorities map[string]entity.Seniority) int {
+	best := -1
+
+	for i, a := range reviewers {
+		if a.Reason == entity.SelectionCodeOwner {
+			continue
+		}
+
+		if best < 0 {
+			best = i
+			continue
+		}
+
+		junior := seniorities[a.UserID] == entity.SeniorityJunior
+		bestJunior := seniorities[reviewers[best].UserID] == entity.SeniorityJunior
+
+		switch {
+		case junior != bestJunior:
+			if junior {
+				best = i
+			}
+		case a.Score <= reviewers[best].Score:
+			best = i
+		}
+	}
+
+	return best
+}
+
+func (s *ReviewerSelector) seniorCandidate(ctx context.Context, q entity.ReviewerQuery, skip []string, trace *selectionTrace) (*entity.ReviewerAssignment, error) {
+	fallbackTeams, err := s.teamRep.GetFallbackTeams(ctx, q.TeamName)
+	if err != nil {
+		return nil, fmt.Errorf("get fallback teams: %w", err)
+	}
+
+	for i, teamName := range append([]string{q.TeamName}, fallbackTeams...) {
+		candidates, err := s.teamCandidates(ctx, teamName, trace, skip...)
+		if err != nil {
+			return nil, err
+		}
+
+		seniorities, err := s.userRep.GetSeniorities(ctx, candidates)
+		if err != nil {
+			return nil, fmt.Errorf("get seniorities: %w", err)
+		}
+
+		for _, userId := range candidates {
+			if seniorities[userId] == entity.SenioritySenior {
+				return &entity.ReviewerAssignment{UserID: userId, TeamName: teamName, Fallback: i > 0, Reason: entity.SelectionMentorship}, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *ReviewerSelector) ownerCandidates(ctx context.Context, rule *entity.CodeOwnerRule, skip []string, trace *selectionTrace) ([]entity.ReviewerAssignment, error) {
+	owners := make([]entity.ReviewerAssignment, 0)
+
+	for _, ownerTeam := range rule.OwnerTeams {
+		candidates, err := s.teamCandidates(ctx, ownerTeam, trace, skip...)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, userId := range candidates {
+			owners = append(owners, entity.ReviewerAssignment{UserID: userId, TeamName: ownerTeam, Reason: entity.SelectionCodeOwner})
+		}
+	}
+
+	for _, userId := range rule.OwnerUsers {
+		if slices.Contains(skip, userId) {
+			continue
+		}
+
+		user, err := s.userRep.GetUserById(ctx, userId)
+		if err != nil {
+			if errors.Is(err, entity.ErrNotFound) {
+				continue
+			}
+			return nil, fmt.Errorf("get code owner: %w", err)
+		}
+
+		candidates, err := s.teamCandidates(ctx, user.TeamName, trace, skip...)
+		if err != nil {
+			return nil, err
+		}
+
+		if slices.Contains(candidates, userId) {
+			owners = append(owners, entity.ReviewerAssignment{UserID: userId, TeamName: user.TeamName, Reason: entity.SelectionCodeOwner})
+		}
+	}
+
+	shuffleAssignments(owners)
+
+	return owners, nil
+}
+
+func (s *ReviewerSelector) teamCandidates(ctx context.Context, teamName string, trace *selectionTrace, exclude ...string) ([]string, error) {
+	activeUsers, err := s.userRep.GetActiveUsersByTeam(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("get active users: %w", err)
+	}
+
+	isActive := false
+	inactiveUsers, err := s.userRep.ListUsers(ctx, &entity.UserFilter{TeamName: &teamName, IsActive: &isActive})
+	if err != nil {
+		return nil, fmt.Errorf("get inactive users: %w", err)
+	}
+
+	for _, user := range inactiveUsers {
+		if slices.Contains(exclude, user.UserID) {
+			trace.skip(user.UserID)
+			continue
+		}
+		trace.exclude(user.UserID, entity.ExclusionInactive)
+	}
+
+	unavailable, err := s.availabilityRep.GetUnavailableUsersByTeam(ctx, teamName, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("get unavailable users: %w", err)
+	}
+
+	if len(unavailable) > 0 {
+		s.logger.Info("skipping unavailable users", "team_name", teamName, "user_ids", unavailable)
+	}
+
+	atCapacity, err := s.userRep.GetUsersAtCapacity(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("get users at capacity: %w", err)
+	}
+
+	if len(atCapacity) > 0 {
+		s.logger.Info("skipping users at review capacity", "team_name", teamName, "user_ids", atCapacity)
+	}
+
+	candidates := make([]string, 0, len(activeUsers))
+
+	for _, userId := range activeUsers {
+		switch {
+		case slices.Contains(exclude, userId):
+			trace.skip(userId)
+		case slices.Contains(unavailable, userId):
+			trace.exclude(userId, entity.ExclusionOutOfOffice)
+		case slices.Contains(atCapacity, userId):
+			trace.exclude(userId, entity.ExclusionAtCapacity)
+		default:
+			trace.candidate(userId)
+			candidates = append(candidates, userId)
+		}
+	}
+
+	shuffleCandidates(candidates)
+
+	return candidates, nil
+}
+
+func (s *ReviewerSelector) rank(ctx context.Context, teamName string, candidates []string, tags []string, recentReviews map[string]int) (map[string]int, map[string]bool, error) {
+	userTags := make(map[string][]string)
+
+	if len(tags) > 0 {
+		var err error
+		if userTags, err = s.userRep.GetTagsByTeam(ctx, teamName); err != nil {
+			return nil, nil, fmt.Errorf("get user tags: %w", err)
+		}
+	}
+
+	workingHours, err := s.userRep.GetWorkingHours(ctx, candidates)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get working hours: %w", err)
+	}
+
+	now := time.Now()
+	scores := make(map[string]int, len(candidates))
+	atWork := make(map[string]bool, len(candidates))
+
+	for _, userId := range candidates {
+		scores[userId] = entity.TagsOverlap(userTags[userId], tags) - recentReviews[userId]
+		atWork[userId] = workingHours[userId].IsWorking(now)
+	}
+
+	slices.SortStableFunc(candidates, func(a, b string) int {
+		if scores[a] != scores[b] {
+			return scores[b] - scores[a]
+		}
+		switch {
+		case atWork[a] && !atWork[b]:
+			return -1
+		case atWork[b] && !atWork[a]:
+			return 1
+		}
+		return 0
+	})
+
+	return scores, atWork, nil
+}
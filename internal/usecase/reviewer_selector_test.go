@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"pullrequest-service/internal/entity"
+	"testing"
+)
+
+func TestEvictionIndex(t *testing.T) {
+	seniorities := map[string]entity.Seniority{
+		"owner":  entity.SeniorityJunior,
+		"junior": entity.SeniorityJunior,
+		"middle": entity.SeniorityMiddle,
+		"low":    entity.SeniorityMiddle,
+	}
+
+	tests := []struct {
+		name      string
+		reviewers []entity.ReviewerAssignment
+		want      int
+	}{
+		{
+			name: "code owner is never replaced",
+			reviewers: []entity.ReviewerAssignment{
+				{UserID: "middle", Reason: entity.SelectionTeam, Score: 1},
+				{UserID: "owner", Reason: entity.SelectionCodeOwner},
+			},
+			want: 0,
+		},
+		{
+			name: "junior is replaced before a non-junior",
+			reviewers: []entity.ReviewerAssignment{
+				{UserID: "junior", Reason: entity.SelectionTeam, Score: 5},
+				{UserID: "middle", Reason: entity.SelectionTeam, Score: 0},
+			},
+			want: 0,
+		},
+		{
+			name: "lowest score is replaced among non-juniors",
+			reviewers: []entity.ReviewerAssignment{
+				{UserID: "low", Reason: entity.SelectionTeam, Score: -1},
+				{UserID: "middle", Reason: entity.SelectionFallback, Score: 2},
+			},
+			want: 0,
+		},
+		{
+			name: "only code owners",
+			reviewers: []entity.ReviewerAssignment{
+				{UserID: "owner", Reason: entity.SelectionCodeOwner},
+			},
+			want: -1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := evictionIndex(tt.reviewers, seniorities); got != tt.want {
+				t.Errorf("evictionIndex() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"pullrequest-service/internal/entity"
+)
+
+type ReviewerSyncUsecase struct {
+	accountRep AccountRepository
+	publishers []ReviewerPublisher
+	logger     *slog.Logger
+}
+
+func NewReviewerSyncUsecase(accountRep AccountRepository, publishers []ReviewerPublisher, logger *slog.Logger) *ReviewerSyncUsecase {
+	return &ReviewerSyncUsecase{accountRep: accountRep, publishers: publishers, logger: logger}
+}
+
+func (u *ReviewerSyncUsecase) Name() string {
+	return "reviewers"
+}
+
+func (u *ReviewerSyncUsecase) Transactional() bool {
+	return false
+}
+
+func (u *ReviewerSyncUsecase) Deliver(ctx context.Context, event entity.Event) error {
+	switch event.Type {
+	case entity.EventReviewerAssigned, entity.EventReviewerReassigned, entity.EventReviewerRemoved:
+	default:
+		return nil
+	}
+
+	if event.PullRequest == nil {
+		return nil
+	}
+
+	prId := event.PullRequest.PullRequestID
+
+	publisher := u.publisherFor(prId)
+	if publisher == nil {
+		return nil
+	}
+
+	update, err := u.buildUpdate(ctx, publisher.Provider(), &event)
+	if err != nil {
+		return err
+	}
+
+	if err := publisher.PublishReviewers(ctx, update); err != nil {
+		if errors.Is(err, entity.ErrCodeHostRejected) {
+			u.logger.Error("code host rejected reviewers, giving up", "provider", publisher.Provider(), "pull_request_id", prId, "error", err)
+			return nil
+		}
+
+		u.logger.Warn("failed to publish reviewers", "provider", publisher.Provider(), "pull_request_id", prId, "error", err)
+		return err
+	}
+
+	u.logger.Info("reviewers published to code host", "provider", publisher.Provider(), "pull_request_id", prId,
+		"reviewers", update.Reviewers, "removed", update.Removed)
+
+	return nil
+}
+
+func (u *ReviewerSyncUsecase) publisherFor(prId string) ReviewerPublisher {
+	for _, publisher := range u.publishers {
+		if publisher.Owns(prId) {
+			return publisher
+		}
+	}
+	return nil
+}
+
+func (u *ReviewerSyncUsecase) buildUpdate(ctx context.Context, provider entity.Provider, event *entity.Event) (*entity.ReviewerUpdate, error) {
+	userIds := append([]string{}, event.PullRequest.AssignedReviewers...)
+	if event.OldReviewerID != "" {
+		userIds = append(userIds, event.OldReviewerID)
+	}
+
+	logins, err := u.accountRep.GetLoginsByUsers(ctx, provider, userIds)
+	if err != nil {
+		return nil, err
+	}
+
+	update := &entity.ReviewerUpdate{PullRequestID: event.PullRequest.PullRequestID, Reviewers: make([]string, 0)}
+
+	for _, userId := range event.PullRequest.AssignedReviewers {
+		login, ok := logins[userId]
+		if !ok {
+			u.logger.Warn("reviewer has no code host login, skipping", "provider", provider, "user_id", userId)
+			continue
+		}
+		update.Reviewers = append(update.Reviewers, login)
+	}
+
+	if login, ok := logins[event.OldReviewerID]; ok {
+		update.Removed = []string{login}
+	}
+
+	return update, nil
+}
@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"net/http"
+	"pullrequest-service/internal/entity"
+	"pullrequest-service/internal/integrations/github"
+	"pullrequest-service/internal/integrations/testutil"
+	"testing"
+	"time"
+)
+
+func newTestReviewerSync(host *testutil.FakeHost) *ReviewerSyncUsecase {
+	accounts := &fakeAccountRepository{logins: map[string]string{"u1": "alice", "u2": "bob", "u3": "carol"}}
+	publishers := []ReviewerPublisher{github.NewClient(host.URL, "token", time.Second)}
+	return NewReviewerSyncUsecase(accounts, publishers, discardLogger())
+}
+
+func reassignedEvent() entity.Event {
+	event := entity.NewPREvent(entity.EventReviewerReassigned, &entity.PullRequest{
+		PullRequestID:     "acme/api#42",
+		AuthorID:          "u9",
+		Status:            entity.OPEN,
+		AssignedReviewers: []string{"u1", "u2"},
+	})
+	event.OldReviewerID = "u3"
+	return event
+}
+
+func TestReviewerSyncDeliver(t *testing.T) {
+	host := testutil.NewFakeHost()
+	defer host.Close()
+
+	if err := newTestReviewerSync(host).Deliver(context.Background(), reassignedEvent()); err != nil {
+		t.Fatalf("Deliver() error = %v", err)
+	}
+
+	requests := host.Requests()
+	if len(requests) != 2 {
+		t.Fatalf("got %d requests, want 2", len(requests))
+	}
+
+	if requests[0].Method != http.MethodDelete || string(requests[0].Body) != `{"reviewers":["carol"]}` {
+		t.Errorf("first request = %s %s, want the old reviewer removed", requests[0].Method, requests[0].Body)
+	}
+
+	if requests[1].Method != http.MethodPost || string(requests[1].Body) != `{"reviewers":["alice","bob"]}` {
+		t.Errorf("second request = %s %s, want the reviewers requested", requests[1].Method, requests[1].Body)
+	}
+}
+
+func TestReviewerSyncDeliverRemoved(t *testing.T) {
+	host := testutil.NewFakeHost()
+	defer host.Close()
+
+	event := entity.NewPREvent(entity.EventReviewerRemoved, &entity.PullRequest{
+		PullRequestID:     "acme/api#42",
+		AuthorID:          "u9",
+		Status:            entity.OPEN,
+		AssignedReviewers: []string{"u1"},
+	})
+	event.OldReviewerID = "u2"
+
+	if err := newTestReviewerSync(host).Deliver(context.Background(), event); err != nil {
+		t.Fatalf("Deliver() error = %v", err)
+	}
+
+	requests := host.Requests()
+	if len(requests) == 0 || requests[0].Method != http.MethodDelete || string(requests[0].Body) != `{"reviewers":["bob"]}` {
+		t.Errorf("requests = %+v, want the removed reviewer dropped on the code host", requests)
+	}
+}
+
+func TestReviewerSyncDeliverTransientFailure(t *testing.T) {
+	host := testutil.NewFakeHost()
+	defer host.Close()
+
+	reviewerSync := newTestReviewerSync(host)
+	host.FailNext(http.StatusBadGateway)
+
+	if err := reviewerSync.Deliver(context.Background(), reassignedEvent()); err == nil {
+		t.Fatal("Deliver() error = nil, want the 5xx returned so the outbox retries it")
+	}
+
+	if got := len(host.Requests()); got != 1 {
+		t.Fatalf("got %d requests, want 1: retries belong to the outbox", got)
+	}
+
+	if err := reviewerSync.Deliver(context.Background(), reassignedEvent()); err != nil {
+		t.Fatalf("retried Deliver() error = %v", err)
+	}
+
+	if got := len(host.Requests()); got != 3 {
+		t.Errorf("got %d requests after the retry, want 3", got)
+	}
+}
+
+func TestReviewerSyncDeliverRejected(t *testing.T) {
+	host := testutil.NewFakeHost()
+	defer host.Close()
+
+	host.FailNext(http.StatusUnprocessableEntity)
+
+	if err := newTestReviewerSync(host).Deliver(context.Background(), reassignedEvent()); err != nil {
+		t.Fatalf("Deliver() error = %v, want a 4xx to be given up", err)
+	}
+
+	if got := len(host.Requests()); got != 1 {
+		t.Errorf("got %d requests, want 1", got)
+	}
+}
+
+func TestReviewerSyncDeliverSkipsForeignPR(t *testing.T) {
+	host := testutil.NewFakeHost()
+	defer host.Close()
+
+	event := reassignedEvent()
+	event.PullRequest.PullRequestID = "pr-1001"
+
+	if err := newTestReviewerSync(host).Deliver(context.Background(), event); err != nil {
+		t.Fatalf("Deliver() error = %v", err)
+	}
+
+	if got := len(host.Requests()); got != 0 {
+		t.Errorf("got %d requests, want none for a PR without a code host", got)
+	}
+}
@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"math/rand"
+	"pullrequest-service/internal/entity"
+	"slices"
+)
+
+func excludeCandidates(users []string, exclude ...string) []string {
+	candidates := make([]string, 0, len(users))
+
+	for _, userId := range users {
+		if !slices.Contains(exclude, userId) {
+			candidates = append(candidates, userId)
+		}
+	}
+
+	return candidates
+}
+
+func shuffleCandidates(candidates []string) {
+	rand.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+}
+
+func shuffleAssignments(assignments []entity.ReviewerAssignment) {
+	rand.Shuffle(len(assignments), func(i, j int) {
+		assignments[i], assignments[j] = assignments[j], assignments[i]
+	})
+}
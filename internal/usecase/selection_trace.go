@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"pullrequest-service/internal/entity"
+	"slices"
+)
+
+type selectionTrace struct {
+	authorId string
+	pool     map[string]struct{}
+	selected map[string]struct{}
+	excluded []entity.ExcludedCandidate
+}
+
+func newSelectionTrace(authorId string) *selectionTrace {
+	return &selectionTrace{authorId: authorId, pool: make(map[string]struct{}), selected: make(map[string]struct{})}
+}
+
+func (t *selectionTrace) candidate(userId string) {
+	t.pool[userId] = struct{}{}
+}
+
+func (t *selectionTrace) markSelected(userId string) {
+	t.selected[userId] = struct{}{}
+}
+
+func (t *selectionTrace) evict(userId string, reason entity.ExclusionReason) {
+	delete(t.selected, userId)
+	t.exclude(userId, reason)
+}
+
+func (t *selectionTrace) skip(userId string) {
+	if userId == t.authorId {
+		t.exclude(userId, entity.ExclusionAuthor)
+		return
+	}
+	t.exclude(userId, entity.ExclusionAlreadyAssigned)
+}
+
+func (t *selectionTrace) exclude(userId string, reason entity.ExclusionReason) {
+	if _, ok := t.selected[userId]; ok {
+		return
+	}
+
+	if slices.ContainsFunc(t.excluded, func(e entity.ExcludedCandidate) bool { return e.UserID == userId }) {
+		return
+	}
+
+	t.excluded = append(t.excluded, entity.ExcludedCandidate{UserID: userId, Reason: reason})
+}
+
+func (t *selectionTrace) explanation(reviewers []entity.ReviewerAssignment) entity.SelectionExplanation {
+	return entity.SelectionExplanation{
+		PoolSize:  len(t.pool),
+		Excluded:  t.excluded,
+		Reviewers: slices.Clone(reviewers),
+	}
+}
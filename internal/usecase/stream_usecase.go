@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"pullrequest-service/internal/entity"
+	"sync"
+)
+
+const (
+	streamBacklogPage = 500
+	streamBuffer      = 256
+)
+
+type StreamUsecase struct {
+	outboxRep OutboxRepository
+	teamRep   TeamRepository
+	userRep   UserRepository
+	hub       EventHub
+	logger    *slog.Logger
+}
+
+func NewStreamUsecase(outboxRep OutboxRepository, teamRep TeamRepository, userRep UserRepository, hub EventHub, logger *slog.Logger) *StreamUsecase {
+	return &StreamUsecase{outboxRep: outboxRep, teamRep: teamRep, userRep: userRep, hub: hub, logger: logger}
+}
+
+func (u *StreamUsecase) Subscribe(ctx context.Context, filter *entity.EventStreamFilter, lastEventId string) (*entity.EventSubscription, error) {
+	u.logger.Info("start subscribing to event stream", "team_name", filter.TeamName, "user_id", filter.UserID, "last_event_id", lastEventId)
+
+	if filter.TeamName != "" && filter.UserID != "" {
+		u.logger.Warn("invalid stream filter: both team_name and user_id set")
+		return nil, entity.ErrInvalidRequest
+	}
+
+	userIds, err := u.filterUsers(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	match := func(event *entity.Event) bool {
+		if event.PullRequest == nil {
+			return false
+		}
+		if userIds == nil {
+			return true
+		}
+		for _, userId := range event.UserIDs() {
+			if _, ok := userIds[userId]; ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	live, unsubscribe := u.hub.Subscribe(streamBuffer)
+
+	backlog, err := u.backlog(ctx, lastEventId, match)
+	if err != nil {
+		unsubscribe()
+		return nil, err
+	}
+
+	seen := make(map[string]struct{}, len(backlog))
+	for _, event := range backlog {
+		seen[event.ID] = struct{}{}
+	}
+
+	events := make(chan entity.Event)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(events)
+		for event := range live {
+			if _, ok := seen[event.ID]; ok || !match(&event) {
+				continue
+			}
+			select {
+			case events <- event:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	closeSubscription := func() {
+		once.Do(func() {
+			close(done)
+			unsubscribe()
+		})
+	}
+
+	u.logger.Info("subscribed to event stream", "team_name", filter.TeamName, "user_id", filter.UserID, "backlog", len(backlog))
+
+	return &entity.EventSubscription{Backlog: backlog, Events: events, Close: closeSubscription}, nil
+}
+
+func (u *StreamUsecase) filterUsers(ctx context.Context, filter *entity.EventStreamFilter) (map[string]struct{}, error) {
+	switch {
+	case filter.UserID != "":
+		if _, err := u.userRep.IsUserExist(ctx, filter.UserID); err != nil {
+			if errors.Is(err, entity.ErrNotFound) {
+				u.logger.Warn("user not found", "user_id", filter.UserID)
+				return nil, err
+			}
+			u.logger.Error("failed to check user existence", "user_id", filter.UserID, "error", err)
+			return nil, entity.ErrInternalError
+		}
+		return map[string]struct{}{filter.UserID: {}}, nil
+	case filter.TeamName != "":
+		team, err := u.teamRep.GetTeamByName(ctx, filter.TeamName)
+		if err != nil {
+			if errors.Is(err, entity.ErrNotFound) {
+				u.logger.Warn("team not found", "team_name", filter.TeamName)
+				return nil, err
+			}
+			u.logger.Error("failed to get team", "team_name", filter.TeamName, "error", err)
+			return nil, entity.ErrInternalError
+		}
+		userIds := make(map[string]struct{}, len(team.Members))
+		for _, member := range team.Members {
+			userIds[member.UserID] = struct{}{}
+		}
+		return userIds, nil
+	default:
+		return nil, nil
+	}
+}
+
+func (u *StreamUsecase) backlog(ctx context.Context, lastEventId string, match func(*entity.Event) bool) ([]entity.Event, error) {
+	backlog := make([]entity.Event, 0)
+
+	for cursor := lastEventId; cursor != ""; {
+		page, err := u.outboxRep.GetEventsAfter(ctx, cursor, streamBacklogPage)
+		if err != nil {
+			if errors.Is(err, entity.ErrNotFound) {
+				u.logger.Warn("unknown last event id, streaming live events only", "last_event_id", lastEventId)
+				return backlog, nil
+			}
+			u.logger.Error("failed to get events after cursor", "last_event_id", cursor, "error", err)
+			return nil, entity.ErrInternalError
+		}
+
+		for i := range page {
+			if match(&page[i]) {
+				backlog = append(backlog, page[i])
+			}
+		}
+
+		cursor = ""
+		if len(page) == streamBacklogPage {
+			cursor = page[len(page)-1].ID
+		}
+	}
+
+	return backlog, nil
+}
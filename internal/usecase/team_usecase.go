@@ -3,21 +3,29 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
 	"pullrequest-service/internal/entity"
+	"slices"
 	"time"
 
 	"log/slog"
 )
 
+var errDryRunRollback = errors.New("dry run rollback")
+
 type TeamUsecase struct {
-	teamRep TeamRepository
-	userRep UserRepository
-	txMgr   TxManager
-	logger  *slog.Logger
+	teamRep  TeamRepository
+	userRep  UserRepository
+	prRep    PRRepository
+	selector *ReviewerSelector
+	txMgr    TxManager
+	events   EventPublisher
+	logger   *slog.Logger
 }
 
-func NewTeamUsecase(teamRep TeamRepository, userRep UserRepository, txMgr TxManager, logger *slog.Logger) *TeamUsecase {
-	return &TeamUsecase{teamRep: teamRep, userRep: userRep, txMgr: txMgr, logger: logger}
+func NewTeamUsecase(teamRep TeamRepository, userRep UserRepository, prRep PRRepository, selector *ReviewerSelector, txMgr TxManager, events EventPublisher,
+	logger *slog.Logger) *TeamUsecase {
+	return &TeamUsecase{teamRep: teamRep, userRep: userRep, prRep: prRep, selector: selector, txMgr: txMgr, events: events, logger: logger}
 }
 
 func (u *TeamUsecase) AddTeam(ctx context.Context, team *entity.Team) error {
@@ -54,7 +62,8 @@ func (u *TeamUsecase) AddTeam(ctx context.Context, team *entity.Team) error {
 				return entity.ErrUserInAnotherTeam
 			}
 
-			user := &entity.User{UserID: member.UserID, UserName: member.UserName, IsActive: member.IsActive, TeamName: team.TeamName}
+			user := &entity.User{UserID: member.UserID, UserName: member.UserName, IsActive: member.IsActive, TeamName: team.TeamName,
+				Tags: member.Tags, Seniority: member.Seniority}
 			if err := u.userRep.AddUserToTeam(ctx, user); err != nil {
 				u.logger.Error("failed to add user to team", "user_id", member.UserID, "error", err)
 				return entity.ErrInternalError
@@ -100,6 +109,442 @@ func (u *TeamUsecase) GetTeam(ctx context.Context, teamName string) (*entity.Tea
 
 }
 
+func (u *TeamUsecase) SyncTeam(ctx context.Context, team *entity.Team, dryRun bool) (*entity.TeamSyncPlan, error) {
+	u.logger.Info("start syncing team", "team_name", team.TeamName, "members_count", len(team.Members), "dry_run", dryRun)
+
+	if err := team.Validate(); err != nil {
+		u.logger.Info("team validation failed", "team_name", team.TeamName, "error", err)
+		return nil, err
+	}
+
+	var plan *entity.TeamSyncPlan
+
+	operation := func(ctx context.Context) error {
+		plan = &entity.TeamSyncPlan{TeamName: team.TeamName, DryRun: dryRun}
+
+		exists, err := u.teamRep.IsTeamExist(ctx, team.TeamName)
+		if err != nil {
+			u.logger.Error("failed to check team existence", "team_name", team.TeamName, "error", err)
+			return entity.ErrInternalError
+		}
+
+		current := make(map[string]entity.TeamMember)
+
+		if exists {
+			currentTeam, err := u.teamRep.GetTeamByName(ctx, team.TeamName)
+			if err != nil && !errors.Is(err, entity.ErrNotFound) {
+				u.logger.Error("failed to get team", "team_name", team.TeamName, "error", err)
+				return entity.ErrInternalError
+			}
+
+			if currentTeam != nil {
+				for _, member := range currentTeam.Members {
+					current[member.UserID] = member
+				}
+			}
+		} else {
+			plan.TeamCreated = true
+
+			if err := u.teamRep.CreateNewTeam(ctx, team.TeamName); err != nil {
+				u.logger.Error("failed to create team", "team_name", team.TeamName, "error", err)
+				return entity.ErrInternalError
+			}
+		}
+
+		desired := make(map[string]struct{}, len(team.Members))
+
+		for _, member := range team.Members {
+			desired[member.UserID] = struct{}{}
+
+			if err := u.syncMember(ctx, plan, current, member); err != nil {
+				return err
+			}
+		}
+
+		removed := make([]entity.TeamMember, 0)
+
+		for userId, member := range current {
+			if _, ok := desired[userId]; ok || !member.IsActive {
+				continue
+			}
+
+			removed = append(removed, member)
+			plan.Removed = append(plan.Removed, userId)
+		}
+
+		for _, member := range removed {
+			if err := u.removeMember(ctx, plan, member); err != nil {
+				return err
+			}
+		}
+
+		if dryRun {
+			return errDryRunRollback
+		}
+
+		return nil
+	}
+
+	err := withRetry(ctx, func(txContext context.Context) error {
+		return u.txMgr.WithTx(txContext, operation)
+	}, 3)
+
+	if err != nil && !errors.Is(err, errDryRunRollback) {
+		return nil, err
+	}
+
+	u.logger.Info("team synced successfully", "team_name", team.TeamName, "dry_run", dryRun,
+		"created", len(plan.Created), "updated", len(plan.Updated), "removed", len(plan.Removed), "reassigned", len(plan.Reassigned))
+
+	return plan, nil
+}
+
+func (u *TeamUsecase) syncMember(ctx context.Context, plan *entity.TeamSyncPlan, current map[string]entity.TeamMember, member entity.TeamMember) error {
+	existing, ok := current[member.UserID]
+	if !ok {
+		teamForMember, err := u.teamRep.GetTeamNameByUserId(ctx, member.UserID)
+		if err != nil && !errors.Is(err, entity.ErrNotFound) {
+			u.logger.Error("failed to get user's team", "user_id", member.UserID, "error", err)
+			return entity.ErrInternalError
+		}
+
+		if teamForMember != nil {
+			u.logger.Warn("user already in another team", "user_id", member.UserID, "team_name", *teamForMember)
+			return entity.ErrUserInAnotherTeam
+		}
+
+		plan.Created = append(plan.Created, member)
+
+		user := &entity.User{UserID: member.UserID, UserName: member.UserName, IsActive: member.IsActive, TeamName: plan.TeamName,
+			Tags: member.Tags, Seniority: member.Seniority}
+		if err := u.userRep.AddUserToTeam(ctx, user); err != nil {
+			u.logger.Error("failed to add user to team", "user_id", member.UserID, "error", err)
+			return entity.ErrInternalError
+		}
+
+		return nil
+	}
+
+	tagsChanged := member.Tags != nil && !slices.Equal(existing.Tags, member.Tags)
+	seniorityChanged := member.Seniority != "" && existing.Seniority != member.Seniority
+
+	if existing.UserName == member.UserName && existing.IsActive == member.IsActive && !tagsChanged && !seniorityChanged {
+		return nil
+	}
+
+	plan.Updated = append(plan.Updated, member)
+
+	if existing.UserName != member.UserName {
+		if err := u.userRep.SetUsername(ctx, member.UserID, member.UserName); err != nil {
+			u.logger.Error("failed to set username", "user_id", member.UserID, "error", err)
+			return entity.ErrInternalError
+		}
+	}
+
+	if existing.IsActive != member.IsActive {
+		if err := u.userRep.SetActive(ctx, member.UserID, member.IsActive); err != nil {
+			u.logger.Error("failed to set user activity flag", "user_id", member.UserID, "error", err)
+			return entity.ErrInternalError
+		}
+
+		if !member.IsActive {
+			event := entity.NewEvent(entity.EventUserDeactivated)
+			event.User = &entity.User{UserID: member.UserID, UserName: member.UserName, TeamName: plan.TeamName}
+			if err := publishEvents(ctx, u.events, u.logger, event); err != nil {
+				return err
+			}
+		}
+	}
+
+	if tagsChanged {
+		if err := u.userRep.SetTags(ctx, member.UserID, member.Tags); err != nil {
+			u.logger.Error("failed to set user tags", "user_id", member.UserID, "error", err)
+			return entity.ErrInternalError
+		}
+	}
+
+	if seniorityChanged {
+		if err := u.userRep.SetSeniority(ctx, member.UserID, member.Seniority); err != nil {
+			u.logger.Error("failed to set user seniority", "user_id", member.UserID, "error", err)
+			return entity.ErrInternalError
+		}
+	}
+
+	return nil
+}
+
+func (u *TeamUsecase) removeMember(ctx context.Context, plan *entity.TeamSyncPlan, member entity.TeamMember) error {
+	userId := member.UserID
+
+	prList, err := u.prRep.GetAllPRForReviewer(ctx, userId)
+	if err != nil {
+		u.logger.Error("failed to get PR list for user", "user_id", userId, "error", err)
+		return entity.ErrInternalError
+	}
+
+	for _, pr := range prList {
+		if pr.Status != entity.OPEN {
+			continue
+		}
+
+		reviewers, err := u.prRep.GetReviewersIdByPR(ctx, pr.PullRequestID)
+		if err != nil {
+			u.logger.Error("failed to get reviewers", "pull_request_id", pr.PullRequestID, "error", err)
+			return entity.ErrInternalError
+		}
+
+		selection, err := u.selector.Select(ctx, entity.ReviewerQuery{
+			TeamName: plan.TeamName,
+			AuthorID: pr.AuthorID,
+			Count:    1,
+			Exclude:  append(reviewers, plan.Removed...),
+			Retained: excludeCandidates(reviewers, userId),
+		})
+		if err != nil {
+			u.logger.Error("failed to select reviewers", "team_name", plan.TeamName, "error", err)
+			return entity.ErrInternalError
+		}
+
+		change := entity.ReviewerChange{PullRequestID: pr.PullRequestID, OldReviewerID: userId}
+
+		if err := u.prRep.DeleteReviewer(ctx, pr.PullRequestID, userId); err != nil {
+			u.logger.Error("failed to delete reviewer for PR", "pull_request_id", pr.PullRequestID, "old_reviewer_id", userId, "error", err)
+			return entity.ErrInternalError
+		}
+
+		if len(selection.Reviewers) > 0 {
+			change.NewReviewerID = selection.Reviewers[0].UserID
+
+			if err := u.prRep.AddReviewerForPR(ctx, pr.PullRequestID, change.NewReviewerID); err != nil {
+				u.logger.Error("failed to add reviewer to PR", "pull_request_id", pr.PullRequestID, "reviewer_id", change.NewReviewerID, "error", err)
+				return entity.ErrInternalError
+			}
+		} else {
+			u.logger.Warn("no available candidates for PR reviewers", "pull_request_id", pr.PullRequestID)
+		}
+
+		record := &entity.AssignmentRecord{
+			PullRequestID: pr.PullRequestID,
+			Action:        entity.AssignmentReassigned,
+			ReviewerIDs:   entity.ReviewerIDs(selection.Reviewers),
+			OldReviewerID: userId,
+			Explanation:   selection.Explanation,
+		}
+		if err := u.prRep.AddAssignmentRecord(ctx, record); err != nil {
+			u.logger.Error("failed to record reviewer assignment", "pull_request_id", pr.PullRequestID, "error", err)
+			return entity.ErrInternalError
+		}
+
+		plan.Reassigned = append(plan.Reassigned, change)
+
+		if change.NewReviewerID != "" {
+			event := entity.NewPREvent(entity.EventReviewerReassigned, &entity.PullRequest{
+				PullRequestID:     pr.PullRequestID,
+				PullRequestName:   pr.PullRequestName,
+				AuthorID:          pr.AuthorID,
+				Status:            pr.Status,
+				AssignedReviewers: append(excludeCandidates(reviewers, userId), change.NewReviewerID),
+			})
+			event.ReviewerIDs = []string{change.NewReviewerID}
+			event.OldReviewerID = userId
+			if err := publishEvents(ctx, u.events, u.logger, event); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := u.userRep.SetActive(ctx, userId, false); err != nil {
+		u.logger.Error("failed to deactivate removed user", "user_id", userId, "error", err)
+		return entity.ErrInternalError
+	}
+
+	event := entity.NewEvent(entity.EventUserDeactivated)
+	event.User = &entity.User{UserID: member.UserID, UserName: member.UserName, TeamName: plan.TeamName}
+
+	return publishEvents(ctx, u.events, u.logger, event)
+}
+
+func (u *TeamUsecase) SetDefaultMaxOpenReviews(ctx context.Context, teamName string, maxOpenReviews *int) error {
+	u.logger.Info("start setting default max open reviews for team", "team_name", teamName, "max_open_reviews", maxOpenReviews)
+
+	if teamName == "" {
+		u.logger.Warn("invalid team_name: empty", "team_name", teamName)
+		return entity.ErrInvalidRequest
+	}
+
+	if err := entity.ValidateMaxOpenReviews(maxOpenReviews); err != nil {
+		u.logger.Warn("invalid max open reviews", "team_name", teamName, "error", err)
+		return err
+	}
+
+	if err := u.teamRep.SetDefaultMaxOpenReviews(ctx, teamName, maxOpenReviews); err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			u.logger.Warn("team not found", "team_name", teamName, "error", err)
+			return err
+		}
+		u.logger.Error("failed to set default max open reviews", "team_name", teamName, "error", err)
+		return entity.ErrInternalError
+	}
+
+	u.logger.Info("successfully set default max open reviews for team", "team_name", teamName)
+
+	return nil
+}
+
+func (u *TeamUsecase) SetFallbackTeams(ctx context.Context, teamName string, fallbackTeams []string) error {
+	u.logger.Info("start setting fallback teams", "team_name", teamName, "fallback_teams", fallbackTeams)
+
+	if teamName == "" {
+		u.logger.Warn("invalid team_name: empty", "team_name", teamName)
+		return entity.ErrInvalidRequest
+	}
+
+	if err := entity.ValidateFallbackTeams(teamName, fallbackTeams); err != nil {
+		u.logger.Warn("fallback teams validation failed", "team_name", teamName, "error", err)
+		return err
+	}
+
+	operation := func(ctx context.Context) error {
+		exists, err := u.teamRep.IsTeamExist(ctx, teamName)
+		if err != nil {
+			u.logger.Error("failed to check team existence", "team_name", teamName, "error", err)
+			return entity.ErrInternalError
+		}
+
+		if !exists {
+			u.logger.Warn("team not found", "team_name", teamName)
+			return entity.ErrNotFound
+		}
+
+		if err := u.teamRep.SetFallbackTeams(ctx, teamName, fallbackTeams); err != nil {
+			if errors.Is(err, entity.ErrNotFound) {
+				u.logger.Warn("fallback team not found", "team_name", teamName, "error", err)
+				return err
+			}
+			u.logger.Error("failed to set fallback teams", "team_name", teamName, "error", err)
+			return entity.ErrInternalError
+		}
+
+		return nil
+	}
+
+	err := withRetry(ctx, func(txContext context.Context) error {
+		return u.txMgr.WithTx(txContext, operation)
+	}, 3)
+
+	if err == nil {
+		u.logger.Info("successfully set fallback teams", "team_name", teamName)
+	}
+
+	return err
+}
+
+func (u *TeamUsecase) GetFallbackTeams(ctx context.Context, teamName string) ([]string, error) {
+	u.logger.Info("start getting fallback teams", "team_name", teamName)
+
+	if teamName == "" {
+		u.logger.Warn("invalid team_name: empty", "team_name", teamName)
+		return nil, entity.ErrInvalidRequest
+	}
+
+	exists, err := u.teamRep.IsTeamExist(ctx, teamName)
+	if err != nil {
+		u.logger.Error("failed to check team existence", "team_name", teamName, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	if !exists {
+		u.logger.Warn("team not found", "team_name", teamName)
+		return nil, entity.ErrNotFound
+	}
+
+	fallbackTeams, err := u.teamRep.GetFallbackTeams(ctx, teamName)
+	if err != nil {
+		u.logger.Error("failed to get fallback teams", "team_name", teamName, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	u.logger.Info("successfully got fallback teams", "team_name", teamName)
+
+	return fallbackTeams, nil
+}
+
+func (u *TeamUsecase) SetPolicy(ctx context.Context, teamName string, policy *entity.TeamPolicy) error {
+	u.logger.Info("start setting team policy", "team_name", teamName, "mentorship_required", policy.MentorshipRequired,
+		"repeat_pairing_window", policy.RepeatPairingWindow)
+
+	if teamName == "" {
+		u.logger.Warn("invalid team_name: empty", "team_name", teamName)
+		return entity.ErrInvalidRequest
+	}
+
+	if err := policy.Validate(); err != nil {
+		u.logger.Warn("team policy validation failed", "team_name", teamName, "error", err)
+		return err
+	}
+
+	operation := func(ctx context.Context) error {
+		if policy.MentorshipRequired {
+			team, err := u.teamRep.GetTeamByName(ctx, teamName)
+			if err != nil && !errors.Is(err, entity.ErrNotFound) {
+				u.logger.Error("failed to get team", "team_name", teamName, "error", err)
+				return entity.ErrInternalError
+			}
+
+			if team != nil && !slices.ContainsFunc(team.Members, func(m entity.TeamMember) bool {
+				return m.IsActive && m.Seniority == entity.SenioritySenior
+			}) {
+				u.logger.Warn("mentorship policy requires an active senior member", "team_name", teamName)
+				return fmt.Errorf("%w: mentorship policy requires at least one active senior member", entity.ErrInvalidRequest)
+			}
+		}
+
+		if err := u.teamRep.SetTeamPolicy(ctx, teamName, policy); err != nil {
+			if errors.Is(err, entity.ErrNotFound) {
+				u.logger.Warn("team not found", "team_name", teamName, "error", err)
+				return err
+			}
+			u.logger.Error("failed to set team policy", "team_name", teamName, "error", err)
+			return entity.ErrInternalError
+		}
+
+		return nil
+	}
+
+	err := withRetry(ctx, func(txContext context.Context) error {
+		return u.txMgr.WithTx(txContext, operation)
+	}, 3)
+
+	if err == nil {
+		u.logger.Info("successfully set team policy", "team_name", teamName)
+	}
+
+	return err
+}
+
+func (u *TeamUsecase) GetPolicy(ctx context.Context, teamName string) (*entity.TeamPolicy, error) {
+	u.logger.Info("start getting team policy", "team_name", teamName)
+
+	if teamName == "" {
+		u.logger.Warn("invalid team_name: empty", "team_name", teamName)
+		return nil, entity.ErrInvalidRequest
+	}
+
+	policy, err := u.teamRep.GetTeamPolicy(ctx, teamName)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			u.logger.Warn("team not found", "team_name", teamName, "error", err)
+			return nil, err
+		}
+		u.logger.Error("failed to get team policy", "team_name", teamName, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	u.logger.Info("successfully got team policy", "team_name", teamName)
+
+	return policy, nil
+}
+
 func withRetry(ctx context.Context, fun func(context.Context) error, retryCount int) error {
 	if fun == nil {
 		return errors.New("fun operation is nil")
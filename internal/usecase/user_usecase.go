@@ -4,17 +4,26 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"net/mail"
 	"pullrequest-service/internal/entity"
+	"strings"
+)
+
+const (
+	defaultUsersLimit = 50
+	maxUsersLimit     = 500
 )
 
 type UserUsecase struct {
 	userRep UserRepository
 	prRep   PRRepository
+	txMgr   TxManager
+	events  EventPublisher
 	logger  *slog.Logger
 }
 
-func NewUserUsecase(userRep UserRepository, prRep PRRepository, logger *slog.Logger) *UserUsecase {
-	return &UserUsecase{userRep: userRep, prRep: prRep, logger: logger}
+func NewUserUsecase(userRep UserRepository, prRep PRRepository, txMgr TxManager, events EventPublisher, logger *slog.Logger) *UserUsecase {
+	return &UserUsecase{userRep: userRep, prRep: prRep, txMgr: txMgr, events: events, logger: logger}
 }
 
 func (u *UserUsecase) SetActiveFlag(ctx context.Context, userId string, isActive bool) (*entity.User, error) {
@@ -25,6 +34,93 @@ func (u *UserUsecase) SetActiveFlag(ctx context.Context, userId string, isActive
 		return nil, entity.ErrInvalidRequest
 	}
 
+	var user *entity.User
+
+	operation := func(ctx context.Context) error {
+		wasActive, err := u.userRep.IsUserActive(ctx, userId)
+
+		if err != nil {
+			if errors.Is(err, entity.ErrNotFound) {
+				u.logger.Warn("user not found", "user_id", userId)
+				return err
+			}
+			u.logger.Error("error checking user existence", "user_id", userId, "error", err)
+			return entity.ErrInternalError
+		}
+
+		err = u.userRep.SetActive(ctx, userId, isActive)
+		if err != nil {
+			u.logger.Error("failed to set user activity flag", "user_id", userId, "is_active", isActive, "error", err)
+			return entity.ErrInternalError
+		}
+
+		user, err = u.userRep.GetUserById(ctx, userId)
+
+		if err != nil {
+			u.logger.Error("failed to get user", "user_id", userId, "error", err)
+			return entity.ErrInternalError
+		}
+
+		if !wasActive || isActive {
+			return nil
+		}
+
+		event := entity.NewEvent(entity.EventUserDeactivated)
+		event.User = user
+		return publishEvents(ctx, u.events, u.logger, event)
+	}
+
+	err := withRetry(ctx, func(ctx context.Context) error {
+		return u.txMgr.WithTx(ctx, operation)
+	}, 3)
+
+	if err != nil {
+		return nil, err
+	}
+
+	u.logger.Info("successfully set activity flag for user", "user_id", userId, "is_active", isActive)
+
+	return user, nil
+}
+
+func (u *UserUsecase) GetPR(ctx context.Context, userId string) ([]entity.PullRequestShort, error) {
+	u.logger.Info("start get PR list for user", "user_id", userId)
+
+	if userId == "" {
+		u.logger.Warn("invalid user id: empty", "user_id", userId)
+		return nil, entity.ErrInvalidRequest
+	}
+
+	_, err := u.userRep.IsUserExist(ctx, userId)
+
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			u.logger.Warn("user not found", "user_id", userId)
+			return nil, err
+		}
+		u.logger.Error("error checking user existence", "user_id", userId, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	prList, err := u.prRep.GetAllPRForReviewer(ctx, userId)
+
+	if err != nil {
+		u.logger.Error("failed to get PR list for user", "user_id", userId, "error", err)
+	}
+
+	u.logger.Info("successfully got PR list for user", "user_id", userId, "pr_count", len(prList))
+
+	return prList, nil
+}
+
+func (u *UserUsecase) SetUsername(ctx context.Context, userId string, username string) (*entity.User, error) {
+	u.logger.Info("start setting username for user", "user_id", userId, "username", username)
+
+	if userId == "" || username == "" {
+		u.logger.Warn("invalid data: empty fields", "user_id", userId, "username", username)
+		return nil, entity.ErrInvalidRequest
+	}
+
 	_, err := u.userRep.IsUserExist(ctx, userId)
 
 	if err != nil {
@@ -36,9 +132,9 @@ func (u *UserUsecase) SetActiveFlag(ctx context.Context, userId string, isActive
 		return nil, entity.ErrInternalError
 	}
 
-	err = u.userRep.SetActive(ctx, userId, isActive)
+	err = u.userRep.SetUsername(ctx, userId, username)
 	if err != nil {
-		u.logger.Error("failed to set user activity flag", "user_id", userId, "is_active", isActive, "error", err)
+		u.logger.Error("failed to set username", "user_id", userId, "username", username, "error", err)
 		return nil, entity.ErrInternalError
 	}
 
@@ -49,19 +145,72 @@ func (u *UserUsecase) SetActiveFlag(ctx context.Context, userId string, isActive
 		return nil, entity.ErrInternalError
 	}
 
-	u.logger.Info("successfully set activity flag for user", "user_id", userId, "is_active", isActive)
+	u.logger.Info("successfully set username for user", "user_id", userId, "username", username)
 
 	return user, nil
 }
 
-func (u *UserUsecase) GetPR(ctx context.Context, userId string) ([]entity.PullRequestShort, error) {
-	u.logger.Info("start get PR list for user", "user_id", userId)
+func (u *UserUsecase) GetUser(ctx context.Context, userId string) (*entity.User, error) {
+	u.logger.Info("start getting user", "user_id", userId)
+
+	if userId == "" {
+		u.logger.Warn("invalid user id: empty", "user_id", userId)
+		return nil, entity.ErrInvalidRequest
+	}
+
+	user, err := u.userRep.GetUserById(ctx, userId)
+
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			u.logger.Warn("user not found", "user_id", userId)
+			return nil, err
+		}
+		u.logger.Error("failed to get user", "user_id", userId, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	u.logger.Info("successfully got user", "user_id", userId)
+
+	return user, nil
+}
+
+func (u *UserUsecase) ListUsers(ctx context.Context, filter *entity.UserFilter) ([]entity.User, error) {
+	u.logger.Info("start listing users", "limit", filter.Limit, "offset", filter.Offset)
+
+	if filter.Limit == 0 {
+		filter.Limit = defaultUsersLimit
+	}
+
+	if filter.Limit > maxUsersLimit {
+		u.logger.Warn("invalid limit: too large", "limit", filter.Limit)
+		return nil, entity.ErrInvalidRequest
+	}
+
+	users, err := u.userRep.ListUsers(ctx, filter)
+
+	if err != nil {
+		u.logger.Error("failed to list users", "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	u.logger.Info("successfully listed users", "users_count", len(users))
+
+	return users, nil
+}
+
+func (u *UserUsecase) SetMaxOpenReviews(ctx context.Context, userId string, maxOpenReviews *int) (*entity.User, error) {
+	u.logger.Info("start setting max open reviews for user", "user_id", userId, "max_open_reviews", maxOpenReviews)
 
 	if userId == "" {
 		u.logger.Warn("invalid user id: empty", "user_id", userId)
 		return nil, entity.ErrInvalidRequest
 	}
 
+	if err := entity.ValidateMaxOpenReviews(maxOpenReviews); err != nil {
+		u.logger.Warn("invalid max open reviews", "user_id", userId, "error", err)
+		return nil, err
+	}
+
 	_, err := u.userRep.IsUserExist(ctx, userId)
 
 	if err != nil {
@@ -73,13 +222,226 @@ func (u *UserUsecase) GetPR(ctx context.Context, userId string) ([]entity.PullRe
 		return nil, entity.ErrInternalError
 	}
 
-	prList, err := u.prRep.GetAllPRForReviewer(ctx, userId)
+	err = u.userRep.SetMaxOpenReviews(ctx, userId, maxOpenReviews)
+	if err != nil {
+		u.logger.Error("failed to set max open reviews", "user_id", userId, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	user, err := u.userRep.GetUserById(ctx, userId)
 
 	if err != nil {
-		u.logger.Error("failed to get PR list for user", "user_id", userId, "error", err)
+		u.logger.Error("failed to get user", "user_id", userId, "error", err)
+		return nil, entity.ErrInternalError
 	}
 
-	u.logger.Info("successfully got PR list for user", "user_id", userId, "pr_count", len(prList))
+	u.logger.Info("successfully set max open reviews for user", "user_id", userId)
 
-	return prList, nil
+	return user, nil
+}
+
+func (u *UserUsecase) SetTags(ctx context.Context, userId string, tags []string) (*entity.User, error) {
+	u.logger.Info("start setting tags for user", "user_id", userId, "tags", tags)
+
+	if userId == "" {
+		u.logger.Warn("invalid user id: empty", "user_id", userId)
+		return nil, entity.ErrInvalidRequest
+	}
+
+	tags, err := entity.NormalizeTags(tags)
+	if err != nil {
+		u.logger.Warn("invalid tags", "user_id", userId, "error", err)
+		return nil, err
+	}
+
+	_, err = u.userRep.IsUserExist(ctx, userId)
+
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			u.logger.Warn("user not found", "user_id", userId)
+			return nil, err
+		}
+		u.logger.Error("error checking user existence", "user_id", userId, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	err = u.userRep.SetTags(ctx, userId, tags)
+	if err != nil {
+		u.logger.Error("failed to set tags", "user_id", userId, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	user, err := u.userRep.GetUserById(ctx, userId)
+
+	if err != nil {
+		u.logger.Error("failed to get user", "user_id", userId, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	u.logger.Info("successfully set tags for user", "user_id", userId)
+
+	return user, nil
+}
+
+func (u *UserUsecase) SetSeniority(ctx context.Context, userId string, seniority entity.Seniority) (*entity.User, error) {
+	u.logger.Info("start setting seniority for user", "user_id", userId, "seniority", seniority)
+
+	if userId == "" {
+		u.logger.Warn("invalid user id: empty", "user_id", userId)
+		return nil, entity.ErrInvalidRequest
+	}
+
+	if err := seniority.Validate(); err != nil {
+		u.logger.Warn("invalid seniority", "user_id", userId, "error", err)
+		return nil, err
+	}
+
+	_, err := u.userRep.IsUserExist(ctx, userId)
+
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			u.logger.Warn("user not found", "user_id", userId)
+			return nil, err
+		}
+		u.logger.Error("error checking user existence", "user_id", userId, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	err = u.userRep.SetSeniority(ctx, userId, seniority)
+	if err != nil {
+		u.logger.Error("failed to set seniority", "user_id", userId, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	user, err := u.userRep.GetUserById(ctx, userId)
+
+	if err != nil {
+		u.logger.Error("failed to get user", "user_id", userId, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	u.logger.Info("successfully set seniority for user", "user_id", userId)
+
+	return user, nil
+}
+
+func (u *UserUsecase) SetWorkingHours(ctx context.Context, userId string, hours entity.WorkingHours) (*entity.User, error) {
+	u.logger.Info("start setting working hours for user", "user_id", userId, "timezone", hours.Timezone, "work_start", hours.Start, "work_end", hours.End)
+
+	if userId == "" {
+		u.logger.Warn("invalid user id: empty", "user_id", userId)
+		return nil, entity.ErrInvalidRequest
+	}
+
+	hours = hours.OrDefault()
+
+	if err := hours.Validate(); err != nil {
+		u.logger.Warn("invalid working hours", "user_id", userId, "error", err)
+		return nil, err
+	}
+
+	_, err := u.userRep.IsUserExist(ctx, userId)
+
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			u.logger.Warn("user not found", "user_id", userId)
+			return nil, err
+		}
+		u.logger.Error("error checking user existence", "user_id", userId, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	err = u.userRep.SetWorkingHours(ctx, userId, hours)
+	if err != nil {
+		u.logger.Error("failed to set working hours", "user_id", userId, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	user, err := u.userRep.GetUserById(ctx, userId)
+
+	if err != nil {
+		u.logger.Error("failed to get user", "user_id", userId, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	u.logger.Info("successfully set working hours for user", "user_id", userId)
+
+	return user, nil
+}
+
+func (u *UserUsecase) SetEmail(ctx context.Context, userId string, email string) (*entity.User, error) {
+	email = strings.TrimSpace(email)
+	u.logger.Info("start setting email for user", "user_id", userId)
+
+	if userId == "" {
+		u.logger.Warn("invalid user id: empty", "user_id", userId)
+		return nil, entity.ErrInvalidRequest
+	}
+
+	if email != "" {
+		addr, err := mail.ParseAddress(email)
+		if err != nil {
+			u.logger.Warn("invalid email", "user_id", userId, "error", err)
+			return nil, entity.ErrInvalidRequest
+		}
+		email = addr.Address
+	}
+
+	_, err := u.userRep.IsUserExist(ctx, userId)
+
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			u.logger.Warn("user not found", "user_id", userId)
+			return nil, err
+		}
+		u.logger.Error("error checking user existence", "user_id", userId, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	err = u.userRep.SetEmail(ctx, userId, email)
+	if err != nil {
+		u.logger.Error("failed to set email", "user_id", userId, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	user, err := u.userRep.GetUserById(ctx, userId)
+
+	if err != nil {
+		u.logger.Error("failed to get user", "user_id", userId, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	u.logger.Info("successfully set email for user", "user_id", userId)
+
+	return user, nil
+}
+
+func (u *UserUsecase) GetReviewerStats(ctx context.Context, userId string) (*entity.ReviewerStats, error) {
+	u.logger.Info("start getting reviewer stats", "user_id", userId)
+
+	if userId == "" {
+		u.logger.Warn("invalid user id: empty", "user_id", userId)
+		return nil, entity.ErrInvalidRequest
+	}
+
+	_, err := u.userRep.IsUserExist(ctx, userId)
+
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			u.logger.Warn("user not found", "user_id", userId)
+			return nil, err
+		}
+		u.logger.Error("error checking user existence", "user_id", userId, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	stats, err := u.prRep.GetReviewerStats(ctx, userId)
+	if err != nil {
+		u.logger.Error("failed to get reviewer stats", "user_id", userId, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	u.logger.Info("successfully got reviewer stats", "user_id", userId)
+
+	return stats, nil
 }
@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"pullrequest-service/internal/entity"
+	"testing"
+)
+
+func TestSetEmail(t *testing.T) {
+	tests := []struct {
+		name    string
+		email   string
+		want    string
+		wantErr error
+	}{
+		{name: "bare address", email: "alice@example.com", want: "alice@example.com"},
+		{name: "display name is dropped", email: "Alice Smith <alice@example.com>", want: "alice@example.com"},
+		{name: "surrounding spaces", email: "  <alice@example.com> ", want: "alice@example.com"},
+		{name: "empty clears the email", email: "", want: ""},
+		{name: "invalid", email: "alice at example.com", wantErr: entity.ErrInvalidRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := &fakeUserRepository{users: map[string]*entity.User{"u1": {UserID: "u1", Email: "old@example.com"}}}
+			u := NewUserUsecase(users, nil, nil, nil, discardLogger())
+
+			user, err := u.SetEmail(context.Background(), "u1", tt.email)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("SetEmail() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("SetEmail() error = %v", err)
+			}
+
+			if user.Email != tt.want {
+				t.Errorf("stored email = %q, want %q", user.Email, tt.want)
+			}
+		})
+	}
+}
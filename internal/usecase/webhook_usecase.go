@@ -0,0 +1,240 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"pullrequest-service/internal/entity"
+	"time"
+)
+
+const (
+	deliveryBatchSize = 50
+	deliveryLease     = 5 * time.Minute
+)
+
+type WebhookUsecase struct {
+	webhookRep WebhookRepository
+	sender     WebhookSender
+	retry      entity.RetryPolicy
+	logger     *slog.Logger
+}
+
+func NewWebhookUsecase(webhookRep WebhookRepository, sender WebhookSender, retry entity.RetryPolicy, logger *slog.Logger) *WebhookUsecase {
+	return &WebhookUsecase{webhookRep: webhookRep, sender: sender, retry: retry, logger: logger}
+}
+
+func (u *WebhookUsecase) CreateWebhook(ctx context.Context, hook *entity.Webhook) (*entity.Webhook, error) {
+	u.logger.Info("start creating webhook", "url", hook.URL, "event_types", hook.EventTypes)
+
+	if err := hook.Validate(); err != nil {
+		u.logger.Warn("webhook validation failed", "url", hook.URL, "error", err)
+		return nil, err
+	}
+
+	if hook.Secret == "" {
+		hook.Secret = entity.RandomToken(32)
+	}
+
+	id, err := u.webhookRep.CreateWebhook(ctx, hook)
+	if err != nil {
+		u.logger.Error("failed to create webhook", "url", hook.URL, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	created, err := u.webhookRep.GetWebhookById(ctx, id)
+	if err != nil {
+		u.logger.Error("failed to get webhook", "id", id, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	u.logger.Info("webhook created successfully", "id", id)
+
+	return created, nil
+}
+
+func (u *WebhookUsecase) ListWebhooks(ctx context.Context) ([]entity.Webhook, error) {
+	u.logger.Info("start listing webhooks")
+
+	hooks, err := u.webhookRep.ListWebhooks(ctx)
+	if err != nil {
+		u.logger.Error("failed to list webhooks", "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	u.logger.Info("successfully listed webhooks", "count", len(hooks))
+
+	return hooks, nil
+}
+
+func (u *WebhookUsecase) DeleteWebhook(ctx context.Context, id int64) error {
+	u.logger.Info("start deleting webhook", "id", id)
+
+	if id <= 0 {
+		u.logger.Warn("invalid webhook id", "id", id)
+		return entity.ErrInvalidRequest
+	}
+
+	if err := u.webhookRep.DeleteWebhook(ctx, id); err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			u.logger.Warn("webhook not found", "id", id)
+			return err
+		}
+		u.logger.Error("failed to delete webhook", "id", id, "error", err)
+		return entity.ErrInternalError
+	}
+
+	u.logger.Info("webhook deleted successfully", "id", id)
+
+	return nil
+}
+
+func (u *WebhookUsecase) GetDeliveries(ctx context.Context, filter *entity.DeliveryFilter) ([]entity.WebhookDelivery, error) {
+	u.logger.Info("start getting webhook deliveries", "webhook_id", filter.WebhookID, "status", filter.Status)
+
+	if filter.WebhookID <= 0 {
+		u.logger.Warn("invalid webhook id", "webhook_id", filter.WebhookID)
+		return nil, entity.ErrInvalidRequest
+	}
+
+	if _, err := u.webhookRep.GetWebhookById(ctx, filter.WebhookID); err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			u.logger.Warn("webhook not found", "webhook_id", filter.WebhookID)
+			return nil, err
+		}
+		u.logger.Error("failed to get webhook", "webhook_id", filter.WebhookID, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	deliveries, err := u.webhookRep.ListDeliveries(ctx, filter)
+	if err != nil {
+		u.logger.Error("failed to list webhook deliveries", "webhook_id", filter.WebhookID, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	ids := make([]int64, len(deliveries))
+	for i := range deliveries {
+		ids[i] = deliveries[i].ID
+	}
+
+	attempts, err := u.webhookRep.GetAttempts(ctx, ids)
+	if err != nil {
+		u.logger.Error("failed to get webhook attempts", "webhook_id", filter.WebhookID, "error", err)
+		return nil, entity.ErrInternalError
+	}
+
+	for i := range deliveries {
+		deliveries[i].History = attempts[deliveries[i].ID]
+	}
+
+	u.logger.Info("successfully got webhook deliveries", "webhook_id", filter.WebhookID, "count", len(deliveries))
+
+	return deliveries, nil
+}
+
+func (u *WebhookUsecase) Name() string {
+	return "webhook"
+}
+
+func (u *WebhookUsecase) Transactional() bool {
+	return true
+}
+
+func (u *WebhookUsecase) Deliver(ctx context.Context, event entity.Event) error {
+	hooks, err := u.webhookRep.GetWebhooksByEvent(ctx, event.Type)
+	if err != nil {
+		return err
+	}
+
+	for _, hook := range hooks {
+		delivery := &entity.WebhookDelivery{WebhookID: hook.ID, Event: event, NextAttemptAt: event.OccurredAt}
+		if err := u.webhookRep.CreateDelivery(ctx, delivery); err != nil {
+			if errors.Is(err, entity.ErrNotFound) {
+				continue
+			}
+			return err
+		}
+	}
+
+	if len(hooks) > 0 {
+		u.logger.Info("webhook deliveries scheduled", "event_id", event.ID, "type", event.Type, "webhooks", len(hooks))
+	}
+
+	return nil
+}
+
+func (u *WebhookUsecase) DeliverPending(ctx context.Context) error {
+	deliveries, err := u.webhookRep.ClaimDueDeliveries(ctx, time.Now(), deliveryLease, deliveryBatchSize)
+	if err != nil {
+		u.logger.Error("failed to claim webhook deliveries", "error", err)
+		return entity.ErrInternalError
+	}
+
+	hooks := make(map[int64]*entity.Webhook)
+
+	for i := range deliveries {
+		delivery := &deliveries[i]
+
+		hook, ok := hooks[delivery.WebhookID]
+		if !ok {
+			hook, err = u.webhookRep.GetWebhookById(ctx, delivery.WebhookID)
+			if err != nil {
+				if errors.Is(err, entity.ErrNotFound) {
+					continue
+				}
+				u.logger.Error("failed to get webhook", "webhook_id", delivery.WebhookID, "error", err)
+				return entity.ErrInternalError
+			}
+			hooks[delivery.WebhookID] = hook
+		}
+
+		if err := u.deliver(ctx, hook, delivery); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (u *WebhookUsecase) deliver(ctx context.Context, hook *entity.Webhook, delivery *entity.WebhookDelivery) error {
+	delivery.Attempts++
+
+	startedAt := time.Now()
+	statusCode, sendErr := u.sender.Send(ctx, hook, delivery)
+
+	attempt := &entity.WebhookAttempt{DeliveryID: delivery.ID, Attempt: delivery.Attempts, Duration: time.Since(startedAt), AttemptedAt: startedAt}
+	if statusCode != 0 {
+		attempt.StatusCode = &statusCode
+	}
+
+	switch {
+	case sendErr == nil:
+		delivery.Status = entity.DeliveryDelivered
+		delivery.DeliveredAt = &startedAt
+		delivery.LastError = ""
+		u.logger.Info("webhook delivered", "webhook_id", hook.ID, "delivery_id", delivery.ID, "event_id", delivery.Event.ID, "status_code", statusCode)
+	case delivery.Attempts >= u.retry.MaxAttempts:
+		attempt.Error = sendErr.Error()
+		delivery.Status = entity.DeliveryFailed
+		delivery.LastError = attempt.Error
+		u.logger.Warn("webhook delivery failed permanently", "webhook_id", hook.ID, "delivery_id", delivery.ID, "attempts", delivery.Attempts, "error", sendErr)
+	default:
+		attempt.Error = sendErr.Error()
+		delivery.LastError = attempt.Error
+		delivery.NextAttemptAt = time.Now().Add(u.retry.Delay(delivery.Attempts))
+		u.logger.Warn("webhook delivery failed, will retry", "webhook_id", hook.ID, "delivery_id", delivery.ID, "attempts", delivery.Attempts,
+			"next_attempt_at", delivery.NextAttemptAt, "error", sendErr)
+	}
+
+	if err := u.webhookRep.AddAttempt(ctx, attempt); err != nil {
+		u.logger.Error("failed to record webhook attempt", "delivery_id", delivery.ID, "error", err)
+		return entity.ErrInternalError
+	}
+
+	if err := u.webhookRep.UpdateDelivery(ctx, delivery); err != nil {
+		u.logger.Error("failed to update webhook delivery", "delivery_id", delivery.ID, "error", err)
+		return entity.ErrInternalError
+	}
+
+	return nil
+}
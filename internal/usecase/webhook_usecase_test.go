@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"pullrequest-service/internal/entity"
+	"pullrequest-service/internal/webhook"
+	"testing"
+	"time"
+)
+
+func TestWebhookDeliverPending(t *testing.T) {
+	retry := entity.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Minute, MaxDelay: time.Hour}
+
+	tests := []struct {
+		name         string
+		status       int
+		attempts     int
+		wantStatus   entity.DeliveryStatus
+		wantAttempts int
+		wantDelay    time.Duration
+	}{
+		{name: "2xx is delivered", status: http.StatusOK, wantStatus: entity.DeliveryDelivered, wantAttempts: 1},
+		{name: "5xx is retried with backoff", status: http.StatusServiceUnavailable, attempts: 1, wantStatus: entity.DeliveryPending,
+			wantAttempts: 2, wantDelay: retry.Delay(2)},
+		{name: "last attempt fails the delivery", status: http.StatusInternalServerError, attempts: 2, wantStatus: entity.DeliveryFailed,
+			wantAttempts: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			repo := &fakeWebhookRepository{
+				hooks: map[int64]*entity.Webhook{1: {ID: 1, URL: server.URL, Secret: "s3cret"}},
+				deliveries: []entity.WebhookDelivery{{
+					ID:        10,
+					WebhookID: 1,
+					Event:     entity.NewEvent(entity.EventPRMerged),
+					Status:    entity.DeliveryPending,
+					Attempts:  tt.attempts,
+				}},
+			}
+
+			u := NewWebhookUsecase(repo, webhook.NewClient(time.Second), retry, discardLogger())
+
+			startedAt := time.Now()
+			if err := u.DeliverPending(context.Background()); err != nil {
+				t.Fatalf("DeliverPending() error = %v", err)
+			}
+
+			delivery, ok := repo.updated[10]
+			if !ok {
+				t.Fatal("delivery was not updated")
+			}
+
+			if delivery.Status != tt.wantStatus || delivery.Attempts != tt.wantAttempts {
+				t.Errorf("delivery = %s after %d attempts, want %s after %d", delivery.Status, delivery.Attempts, tt.wantStatus, tt.wantAttempts)
+			}
+
+			if len(repo.attempts) != 1 || repo.attempts[0].StatusCode == nil || *repo.attempts[0].StatusCode != tt.status {
+				t.Errorf("attempts = %+v, want one attempt with status %d", repo.attempts, tt.status)
+			}
+
+			if tt.wantDelay > 0 {
+				delay := delivery.NextAttemptAt.Sub(startedAt)
+				if delay < tt.wantDelay || delay > tt.wantDelay+5*time.Second {
+					t.Errorf("next attempt in %s, want %s", delay, tt.wantDelay)
+				}
+			}
+
+			if tt.wantStatus == entity.DeliveryDelivered && (delivery.DeliveredAt == nil || delivery.LastError != "") {
+				t.Errorf("delivered_at = %v, last_error = %q", delivery.DeliveredAt, delivery.LastError)
+			}
+
+			if tt.wantStatus != entity.DeliveryDelivered && delivery.LastError == "" {
+				t.Error("last_error is empty")
+			}
+		})
+	}
+}
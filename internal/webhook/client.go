@@ -0,0 +1,71 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"pullrequest-service/internal/entity"
+	"pullrequest-service/internal/events"
+	"strconv"
+	"time"
+)
+
+const (
+	HeaderEvent      = "X-Webhook-Event"
+	HeaderEventID    = "X-Webhook-Event-Id"
+	HeaderDelivery   = "X-Webhook-Delivery"
+	HeaderSignature  = "X-Webhook-Signature-256"
+	signaturePrefix  = "sha256="
+	maxResponseBytes = 64 << 10
+)
+
+type Client struct {
+	http *http.Client
+}
+
+func NewClient(timeout time.Duration) *Client {
+	return &Client{http: &http.Client{Timeout: timeout}}
+}
+
+func (c *Client) Send(ctx context.Context, hook *entity.Webhook, delivery *entity.WebhookDelivery) (int, error) {
+	body, err := events.Encode(&delivery.Event)
+	if err != nil {
+		return 0, fmt.Errorf("encode payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("build request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, string(delivery.Event.Type))
+	req.Header.Set(HeaderEventID, delivery.Event.ID)
+	req.Header.Set(HeaderDelivery, strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set(HeaderSignature, Sign(hook.Secret, body))
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBytes))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
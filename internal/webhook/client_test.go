@@ -0,0 +1,109 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"pullrequest-service/internal/entity"
+	"testing"
+	"time"
+)
+
+func TestClientSendSigned(t *testing.T) {
+	const secret = "s3cret"
+
+	var received http.Header
+	var verified bool
+	var payload map[string]any
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received = r.Header.Clone()
+		verified = hmac.Equal([]byte(r.Header.Get(HeaderSignature)), []byte(Sign(secret, body)))
+		_ = json.Unmarshal(body, &payload)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	hook := &entity.Webhook{ID: 1, URL: server.URL, Secret: secret}
+	delivery := &entity.WebhookDelivery{ID: 7, Event: entity.NewPREvent(entity.EventPRCreated, &entity.PullRequest{PullRequestID: "pr-1"})}
+
+	status, err := NewClient(time.Second).Send(context.Background(), hook, delivery)
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	if status != http.StatusNoContent {
+		t.Errorf("status = %d, want %d", status, http.StatusNoContent)
+	}
+
+	if !verified {
+		t.Errorf("receiver could not verify %s = %q", HeaderSignature, received.Get(HeaderSignature))
+	}
+
+	headers := map[string]string{
+		HeaderEvent:    string(entity.EventPRCreated),
+		HeaderEventID:  delivery.Event.ID,
+		HeaderDelivery: "7",
+	}
+	for name, want := range headers {
+		if got := received.Get(name); got != want {
+			t.Errorf("%s = %q, want %q", name, got, want)
+		}
+	}
+
+	if payload["id"] != delivery.Event.ID || payload["type"] != string(entity.EventPRCreated) {
+		t.Errorf("payload = %v", payload)
+	}
+}
+
+func TestSignDetectsTampering(t *testing.T) {
+	body := []byte(`{"id":"1"}`)
+	signature := Sign("s3cret", body)
+
+	if hmac.Equal([]byte(signature), []byte(Sign("s3cret", []byte(`{"id":"2"}`)))) {
+		t.Error("signature matches a different body")
+	}
+
+	if hmac.Equal([]byte(signature), []byte(Sign("other", body))) {
+		t.Error("signature matches a different secret")
+	}
+}
+
+func TestClientSendStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{name: "ok", status: http.StatusOK},
+		{name: "accepted", status: http.StatusAccepted},
+		{name: "redirect is not delivered", status: http.StatusNotModified, wantErr: true},
+		{name: "client error", status: http.StatusGone, wantErr: true},
+		{name: "server error", status: http.StatusInternalServerError, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			hook := &entity.Webhook{ID: 1, URL: server.URL, Secret: "s3cret"}
+			delivery := &entity.WebhookDelivery{ID: 1, Event: entity.NewEvent(entity.EventPRMerged)}
+
+			status, err := NewClient(time.Second).Send(context.Background(), hook, delivery)
+			if status != tt.status {
+				t.Errorf("status = %d, want %d", status, tt.status)
+			}
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Send() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+type Periodic struct {
+	name     string
+	interval time.Duration
+	job      func(context.Context) error
+	logger   *slog.Logger
+}
+
+func NewPeriodic(name string, interval time.Duration, job func(context.Context) error, logger *slog.Logger) *Periodic {
+	return &Periodic{name: name, interval: interval, job: job, logger: logger}
+}
+
+func (p *Periodic) Run(ctx context.Context) {
+	p.logger.Info("worker started", "worker", p.name, "interval", p.interval.String())
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			p.logger.Info("worker stopped", "worker", p.name)
+			return
+		case <-ticker.C:
+			if err := p.job(ctx); err != nil {
+				p.logger.Error("worker iteration failed", "worker", p.name, "error", err)
+			}
+		}
+	}
+}
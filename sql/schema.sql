@@ -36,3 +36,186 @@ CREATE TABLE IF NOT EXISTS pr_reviewers (
 CREATE INDEX IF NOT EXISTS idx_pr_reviewers_pr ON pr_reviewers(pull_request_id);
 CREATE INDEX IF NOT EXISTS idx_pr_reviewers_user ON pr_reviewers(user_id);
 
+
+CREATE TABLE IF NOT EXISTS user_unavailability (
+    id BIGSERIAL PRIMARY KEY,
+    user_id TEXT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    starts_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    ends_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    reassign_reviews BOOLEAN NOT NULL DEFAULT FALSE,
+    reviews_reassigned_at TIMESTAMP WITH TIME ZONE,
+    CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_unavailability_user ON user_unavailability(user_id, starts_at, ends_at);
+CREATE INDEX IF NOT EXISTS idx_unavailability_pending ON user_unavailability(starts_at) WHERE reassign_reviews = TRUE AND reviews_reassigned_at IS NULL;
+
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS default_max_open_reviews INTEGER CHECK (default_max_open_reviews > 0);
+ALTER TABLE users ADD COLUMN IF NOT EXISTS max_open_reviews INTEGER CHECK (max_open_reviews > 0);
+
+CREATE TABLE IF NOT EXISTS team_fallbacks (
+    team_name TEXT NOT NULL REFERENCES teams(team_name) ON DELETE CASCADE,
+    fallback_team_name TEXT NOT NULL REFERENCES teams(team_name) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    PRIMARY KEY (team_name, fallback_team_name),
+    CHECK (team_name <> fallback_team_name)
+);
+
+CREATE TABLE IF NOT EXISTS code_owner_rules (
+    id BIGSERIAL PRIMARY KEY,
+    team_name TEXT NOT NULL REFERENCES teams(team_name) ON DELETE CASCADE,
+    pattern TEXT NOT NULL,
+    owner_users TEXT[] NOT NULL DEFAULT '{}',
+    owner_teams TEXT[] NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX IF NOT EXISTS idx_code_owner_rules_team ON code_owner_rules(team_name);
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS seniority TEXT NOT NULL DEFAULT 'middle'
+    CHECK (seniority IN ('junior', 'middle', 'senior'));
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS mentorship_required BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS repeat_pairing_window INT NOT NULL DEFAULT 0
+    CHECK (repeat_pairing_window >= 0);
+
+CREATE TABLE IF NOT EXISTS reviewer_assignments (
+    id BIGSERIAL PRIMARY KEY,
+    pull_request_id TEXT NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    action TEXT NOT NULL,
+    reviewer_ids TEXT[] NOT NULL DEFAULT '{}',
+    old_reviewer_id TEXT,
+    explanation JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_reviewer_assignments_pr ON reviewer_assignments(pull_request_id);
+
+ALTER TABLE reviewer_assignments ADD COLUMN IF NOT EXISTS reason TEXT;
+
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS review_sla_hours INT NOT NULL DEFAULT 0
+    CHECK (review_sla_hours >= 0);
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS sla_action TEXT NOT NULL DEFAULT 'flag'
+    CHECK (sla_action IN ('flag', 'reassign'));
+
+ALTER TABLE pr_reviewers ADD COLUMN IF NOT EXISTS assigned_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW();
+ALTER TABLE pr_reviewers ADD COLUMN IF NOT EXISTS responded_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE pr_reviewers ADD COLUMN IF NOT EXISTS overdue_at TIMESTAMP WITH TIME ZONE;
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS timezone TEXT NOT NULL DEFAULT 'UTC';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS work_start TEXT NOT NULL DEFAULT '09:00';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS work_end TEXT NOT NULL DEFAULT '18:00';
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS email TEXT NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS webhooks (
+    id BIGSERIAL PRIMARY KEY,
+    url TEXT NOT NULL,
+    secret TEXT NOT NULL,
+    event_types TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id BIGSERIAL PRIMARY KEY,
+    webhook_id BIGINT NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE,
+    event_id TEXT NOT NULL,
+    event_type TEXT NOT NULL,
+    event JSONB NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'delivered', 'failed')),
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_error TEXT NOT NULL DEFAULT '',
+    delivered_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (webhook_id, event_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+
+CREATE TABLE IF NOT EXISTS webhook_attempts (
+    id BIGSERIAL PRIMARY KEY,
+    delivery_id BIGINT NOT NULL REFERENCES webhook_deliveries(id) ON DELETE CASCADE,
+    attempt INT NOT NULL,
+    status_code INT,
+    error TEXT NOT NULL DEFAULT '',
+    duration_ms BIGINT NOT NULL,
+    attempted_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_attempts_delivery ON webhook_attempts(delivery_id);
+
+CREATE TABLE IF NOT EXISTS event_outbox (
+    id BIGSERIAL PRIMARY KEY,
+    event_id TEXT NOT NULL UNIQUE,
+    event_type TEXT NOT NULL,
+    ordering_key TEXT NOT NULL,
+    event JSONB NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    delivered_sinks TEXT[] NOT NULL DEFAULT '{}',
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    processed_at TIMESTAMP WITH TIME ZONE,
+    dead_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_outbox_pending_key ON event_outbox(ordering_key, id) WHERE processed_at IS NULL AND dead_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS external_accounts (
+    provider TEXT NOT NULL,
+    login TEXT NOT NULL,
+    user_id TEXT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    PRIMARY KEY (provider, login)
+);
+
+CREATE INDEX IF NOT EXISTS idx_external_accounts_user ON external_accounts(user_id);
+
+CREATE TABLE IF NOT EXISTS unmapped_events (
+    id BIGSERIAL PRIMARY KEY,
+    provider TEXT NOT NULL,
+    login TEXT NOT NULL,
+    pull_request_id TEXT NOT NULL,
+    event JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_unmapped_events_login ON unmapped_events(provider, login);
+CREATE INDEX IF NOT EXISTS idx_unmapped_events_pr ON unmapped_events(pull_request_id);
+
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    user_id TEXT PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    channels JSONB NOT NULL DEFAULT '[]',
+    mute BOOLEAN NOT NULL DEFAULT FALSE,
+    digest_only BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE TABLE IF NOT EXISTS notifications (
+    id BIGSERIAL PRIMARY KEY,
+    user_id TEXT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    event_id TEXT NOT NULL,
+    kind TEXT NOT NULL,
+    pull_request_id TEXT NOT NULL,
+    text TEXT NOT NULL,
+    digest BOOLEAN NOT NULL DEFAULT FALSE,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'delivered', 'failed')),
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_error TEXT NOT NULL DEFAULT '',
+    sent_channels TEXT[] NOT NULL DEFAULT '{}',
+    sent_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (event_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_notifications_due ON notifications(digest, next_attempt_at) WHERE status = 'pending';
+
+CREATE TABLE IF NOT EXISTS email_digests (
+    user_id TEXT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    digest_date DATE NOT NULL,
+    sent_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, digest_date)
+);